@@ -0,0 +1,232 @@
+package algorithm
+
+import (
+	"container/heap"
+	"math"
+
+	err "github.com/elecbug/go-graphtric/err" // Custom error package
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// INF64 is the int64 counterpart of graph.INF.
+// It marks unreachable pairs in the int64 distance matrices returned by FloydWarshall and Johnson.
+const INF64 = int64(math.MaxInt64)
+
+// FloydWarshall computes the distances between all pairs of nodes with the Floyd-Warshall algorithm.
+// It runs in O(n³) time and is best suited to small or dense graphs.
+//
+// Parameters:
+//   - g: The graph to perform the computation on. Negative weights are allowed, see graph.HasNegativeWeights.
+//
+// Returns:
+//   - A distance matrix indexed by node identifier, like ToMatrix; INF64 marks unreachable pairs.
+//     A negative diagonal entry means that its node lies on a negative cycle; distances are then meaningless.
+//
+// Notes:
+//   - Sums of distances saturate instead of overflowing, so a path longer than math.MaxInt64 reads as INF64.
+func FloydWarshall(g *graph.Graph) [][]int64 {
+	dist := initDistances(g)
+	n := len(dist)
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if dist[i][k] == INF64 {
+				continue
+			}
+
+			for j := 0; j < n; j++ {
+				if dist[k][j] != INF64 && addSaturated(dist[i][k], dist[k][j]) < dist[i][j] {
+					dist[i][j] = addSaturated(dist[i][k], dist[k][j])
+				}
+			}
+		}
+	}
+
+	return dist
+}
+
+// BellmanFord computes the distances from a source to every node with the Bellman-Ford algorithm.
+// Unlike Dijkstra's algorithm it tolerates negative edge weights and detects negative cycles.
+//
+// Parameters:
+//   - g: The graph to perform the computation on.
+//   - source: The source node identifier.
+//
+// Returns:
+//   - A map where the keys are reachable node identifiers and the values are their distances from the source.
+//     Distances saturate at INF64 instead of overflowing.
+//   - An error if the source does not exist or a negative cycle is reachable from it.
+func BellmanFord(g *graph.Graph, source graph.Identifier) (map[graph.Identifier]int64, error) {
+	if _, e := g.FindNode(source); e != nil {
+		return nil, e
+	}
+
+	dist := map[graph.Identifier]int64{source: 0}
+	edges := weightedEdges(g)
+
+	for i := 1; i < g.NodeCount(); i++ {
+		changed := false
+
+		for _, e := range edges {
+			if d, ok := dist[e.from]; ok {
+				if old, seen := dist[e.to]; !seen || addSaturated(d, e.weight) < old {
+					dist[e.to] = addSaturated(d, e.weight)
+					changed = true
+				}
+			}
+		}
+
+		if !changed {
+			return dist, nil
+		}
+	}
+
+	// Any further improvement means a negative cycle is reachable.
+	for _, e := range edges {
+		if d, ok := dist[e.from]; ok && addSaturated(d, e.weight) < dist[e.to] {
+			return nil, err.NegativeCycle(source.String())
+		}
+	}
+
+	return dist, nil
+}
+
+// Johnson computes the distances between all pairs of nodes with Johnson's algorithm.
+// The edges are reweighted with potentials from Bellman-Ford so that they become non-negative,
+// then Dijkstra's algorithm runs from every source. This is O(n·m·log n), faster than
+// FloydWarshall on sparse graphs.
+//
+// Parameters:
+//   - g: The graph to perform the computation on.
+//
+// Returns:
+//   - A distance matrix indexed by node identifier, consistent with FloydWarshall; INF64 marks unreachable pairs.
+//   - An error if the graph contains a negative cycle.
+//
+// Notes:
+//   - Negative weights are read as in graph.HasNegativeWeights. Edges are taken from the adjacency lists,
+//     not from ToMatrix, so a weight of -1, which has the bits of INF, is an edge and not a missing one.
+//   - Sums of distances saturate instead of overflowing, so a path longer than math.MaxInt64 reads as INF64.
+func Johnson(g *graph.Graph) ([][]int64, error) {
+	edges := weightedEdges(g)
+
+	// Bellman-Ford from a virtual node linked to every node with weight 0.
+	potential := make(map[graph.Identifier]int64, g.NodeCount())
+	for _, id := range g.NodeIDs() {
+		potential[id] = 0
+	}
+
+	for i := 0; i <= g.NodeCount(); i++ {
+		changed := false
+
+		for _, e := range edges {
+			if addSaturated(potential[e.from], e.weight) < potential[e.to] {
+				potential[e.to] = addSaturated(potential[e.from], e.weight)
+				changed = true
+			}
+		}
+
+		if !changed {
+			break
+		}
+
+		if i == g.NodeCount() {
+			return nil, err.NegativeCycle("virtual")
+		}
+	}
+
+	dist := initDistances(g)
+
+	for _, source := range g.NodeIDs() {
+		reduced := map[graph.Identifier]graph.Distance{source: 0}
+		visited := make(map[graph.Identifier]bool)
+		queue := &distanceHeap{{node: source, distance: 0}}
+
+		for queue.Len() > 0 {
+			item := heap.Pop(queue).(distanceItem)
+			v := item.node
+
+			if visited[v] {
+				continue
+			}
+
+			visited[v] = true
+
+			// A reduced distance that saturated stands for a path too long to represent.
+			if item.distance < graph.Distance(INF64) {
+				dist[source][v] = addSaturated(addSaturated(int64(item.distance), -potential[source]), potential[v])
+			}
+
+			for _, e := range g.NeighborEdges(v) {
+				// The reduced weight w + h(v) - h(w) is non-negative by construction.
+				w := e.To()
+				weight := addSaturated(addSaturated(int64(e.Distance()), potential[v]), -potential[w])
+				alt := graph.Distance(addSaturated(int64(item.distance), weight))
+
+				if d, ok := reduced[w]; !ok || alt < d {
+					reduced[w] = alt
+					heap.Push(queue, distanceItem{node: w, distance: alt})
+				}
+			}
+		}
+	}
+
+	return dist, nil
+}
+
+// weightedEdge is a directed edge with an int64 weight.
+type weightedEdge struct {
+	from, to graph.Identifier
+	weight   int64
+}
+
+// weightedEdges lists every stored edge of the graph; undirected edges appear once per direction.
+func weightedEdges(g *graph.Graph) []weightedEdge {
+	edges := []weightedEdge{}
+
+	for _, from := range sortedIDs(g) {
+		for _, e := range g.NeighborEdges(from) {
+			edges = append(edges, weightedEdge{from: from, to: e.To(), weight: int64(e.Distance())})
+		}
+	}
+
+	return edges
+}
+
+// initDistances creates an identifier-indexed matrix holding the lightest direct edge weights,
+// 0 on the diagonal of existing nodes unless a negative self-loop is lighter, and INF64 elsewhere.
+// The weights are read from the adjacency lists as int64, so negative weights, including -1, are kept.
+func initDistances(g *graph.Graph) [][]int64 {
+	n := len(g.ToMatrix())
+	dist := make([][]int64, n)
+
+	for i := range dist {
+		dist[i] = make([]int64, n)
+
+		for j := range dist[i] {
+			dist[i][j] = INF64
+		}
+	}
+
+	for _, id := range g.NodeIDs() {
+		dist[id][id] = 0
+	}
+
+	for _, e := range weightedEdges(g) {
+		dist[e.from][e.to] = min(dist[e.from][e.to], e.weight)
+	}
+
+	return dist
+}
+
+// addSaturated adds two int64 values, clamping the result to [math.MinInt64, INF64] instead of overflowing.
+func addSaturated(a, b int64) int64 {
+	switch {
+	case b > 0 && a > INF64-b:
+		return INF64
+	case b < 0 && a < math.MinInt64-b:
+		return math.MinInt64
+	}
+
+	return a + b
+}
@@ -0,0 +1,104 @@
+package algorithm
+
+import (
+	"math/rand"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// ApproximateBetweenness estimates the betweenness centrality of each node by sampling source nodes.
+// Brandes' dependency accumulation is run from sampleSize randomly chosen sources only,
+// and the accumulated scores are scaled by n / sampleSize to estimate the full sum.
+//
+// Parameters:
+//   - g: The graph to compute the betweenness centrality for.
+//   - sampleSize: The number of source nodes to sample. Values of n or more use every node (exact Brandes).
+//   - seed: The seed of the random source selection, making the result reproducible.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the estimated betweenness centrality scores,
+//     normalized like BetweennessCentrality.
+//
+// Notes:
+//   - The cost is O(sampleSize * m) for unweighted graphs instead of O(n * m), so it scales linearly with the sample.
+//   - The estimate is unbiased, and its error shrinks roughly with 1/sqrt(sampleSize); high-betweenness nodes are
+//     ranked reliably with small samples, while the order among low-scoring nodes is noisier.
+func (u *Unit) ApproximateBetweenness(g *graph.Graph, sampleSize int, seed int64) map[graph.Identifier]float64 {
+	ids := sortedIDs(g)
+	n := len(ids)
+
+	centrality := make(map[graph.Identifier]float64, n)
+	for _, id := range ids {
+		centrality[id] = 0
+	}
+
+	if sampleSize <= 0 || n == 0 {
+		return centrality
+	}
+
+	if sampleSize > n {
+		sampleSize = n
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	for _, index := range r.Perm(n)[:sampleSize] {
+		source := ids[index]
+		brandesSource(g, source).accumulate(source, centrality)
+	}
+
+	// Scale the sample up to all sources and normalize like BetweennessCentrality.
+	scale := float64(n) / float64(sampleSize)
+	if n > 2 {
+		scale /= float64((n - 1) * (n - 2))
+	}
+
+	for node := range centrality {
+		centrality[node] *= scale
+	}
+
+	return centrality
+}
+
+// ApproximateDiameter estimates the diameter of the graph with the multi-sweep heuristic.
+// A search runs from the smallest identifier, the next one from the farthest node it found, and so on;
+// the largest eccentricity seen is the estimate. On trees two sweeps (the double sweep) are already exact.
+//
+// Parameters:
+//   - g: The graph to estimate the diameter of. Unweighted graphs are searched breadth-first,
+//     weighted graphs with Dijkstra's algorithm.
+//   - sweeps: The number of searches to run; values below 1 run one.
+//
+// Returns:
+//   - A lower bound of the diameter: the largest distance found between two nodes, or 0 for an empty graph.
+//
+// Notes:
+//   - The estimate may underestimate the diameter, but on real-world graphs it is usually exact or very close
+//     after a few sweeps. It costs O(sweeps·(n + m)) for unweighted graphs instead of all-pairs shortest paths.
+//   - Only the nodes reachable from the first node are explored, so on a disconnected graph it estimates the
+//     diameter of that component. Searches follow edge directions in directed graphs.
+//   - Ties for the farthest node are broken toward smaller identifiers, so the result is deterministic.
+func ApproximateDiameter(g *graph.Graph, sweeps int) int64 {
+	ids := sortedIDs(g)
+	if len(ids) == 0 {
+		return 0
+	}
+
+	var estimate int64
+	start := ids[0]
+
+	for i := 0; i < max(1, sweeps); i++ {
+		tree := searchFrom(g, start, start, false)
+
+		farthest := start
+		for _, id := range ids {
+			if d, ok := tree.dist[id]; ok && d > tree.dist[farthest] {
+				farthest = id
+			}
+		}
+
+		estimate = max(estimate, int64(tree.dist[farthest]))
+		start = farthest
+	}
+
+	return estimate
+}
@@ -0,0 +1,56 @@
+package algorithm
+
+import (
+	"math"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// DegreeAssortativity computes Newman's degree assortativity coefficient of the graph.
+// It is the Pearson correlation between the degrees of the two endpoints of every edge.
+// Positive values mean high-degree nodes tend to connect to high-degree nodes, negative values
+// mean they tend to connect to low-degree nodes.
+//
+// Parameters:
+//   - g: The graph to compute the coefficient for. Edge directions are ignored.
+//
+// Returns:
+//   - The assortativity coefficient in [-1, 1].
+//
+// Notes:
+//   - If every edge joins nodes of the same degrees (e.g. a regular graph) the degree variance is zero
+//     and the correlation is undefined; 0 is returned in that case, as well as for graphs without edges.
+func DegreeAssortativity(g *graph.Graph) float64 {
+	neighbors := undirectedNeighbors(g)
+
+	// Accumulate over both orientations of every edge so the measure is symmetric.
+	var count, sumX, sumXY, sumXX float64
+
+	for _, adjacent := range neighbors {
+		x := float64(len(adjacent))
+
+		for neighbor := range adjacent {
+			y := float64(len(neighbors[neighbor]))
+
+			count++
+			sumX += x
+			sumXY += x * y
+			sumXX += x * x
+		}
+	}
+
+	if count == 0 {
+		return 0.0
+	}
+
+	mean := sumX / count
+	variance := sumXX/count - mean*mean
+
+	if variance <= 1e-12 {
+		return 0.0
+	}
+
+	r := (sumXY/count - mean*mean) / variance
+
+	return math.Max(-1, math.Min(1, r))
+}
@@ -0,0 +1,139 @@
+package algorithm
+
+import (
+	"container/heap"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// brandesState holds the single-source shortest-path data used by Brandes' betweenness algorithm.
+type brandesState struct {
+	order []graph.Identifier                      // Nodes in non-decreasing distance from the source.
+	pred  map[graph.Identifier][]graph.Identifier // Predecessors of each node on shortest paths from the source.
+	sigma map[graph.Identifier]float64            // Number of shortest paths from the source to each node.
+	dist  map[graph.Identifier]graph.Distance     // Distance from the source to each reached node.
+}
+
+// brandesSource computes the shortest-path DAG from a single source.
+// Unweighted graphs use BFS; weighted graphs use Dijkstra's algorithm.
+//
+// Parameters:
+//   - g: The graph to traverse.
+//   - source: The source node identifier.
+//
+// Returns:
+//   - The visiting order, predecessor lists, path counts, and distances of every reachable node.
+func brandesSource(g *graph.Graph, source graph.Identifier) brandesState {
+	state := brandesState{
+		order: []graph.Identifier{},
+		pred:  make(map[graph.Identifier][]graph.Identifier),
+		sigma: map[graph.Identifier]float64{source: 1},
+		dist:  map[graph.Identifier]graph.Distance{source: 0},
+	}
+
+	if g.Type() == graph.DirectedUnweighted || g.Type() == graph.UndirectedUnweighted {
+		queue := []graph.Identifier{source}
+
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			state.order = append(state.order, v)
+
+			for _, w := range g.Neighbors(v) {
+				if _, seen := state.dist[w]; !seen {
+					state.dist[w] = state.dist[v] + 1
+					queue = append(queue, w)
+				}
+
+				if state.dist[w] == state.dist[v]+1 {
+					state.sigma[w] += state.sigma[v]
+					state.pred[w] = append(state.pred[w], v)
+				}
+			}
+		}
+
+		return state
+	}
+
+	visited := make(map[graph.Identifier]bool)
+	queue := &distanceHeap{{node: source, distance: 0}}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(distanceItem)
+		v := item.node
+
+		if visited[v] || item.distance > state.dist[v] {
+			continue
+		}
+
+		visited[v] = true
+		state.order = append(state.order, v)
+
+		for _, e := range g.NeighborEdges(v) {
+			w := e.To()
+			alt := state.dist[v] + e.Distance()
+			d, seen := state.dist[w]
+
+			switch {
+			case !seen || alt < d:
+				state.dist[w] = alt
+				state.sigma[w] = state.sigma[v]
+				state.pred[w] = []graph.Identifier{v}
+				heap.Push(queue, distanceItem{node: w, distance: alt})
+			case alt == d && !visited[w]:
+				state.sigma[w] += state.sigma[v]
+				state.pred[w] = append(state.pred[w], v)
+			}
+		}
+	}
+
+	return state
+}
+
+// accumulate adds the dependencies of the source onto every other node of the shortest-path DAG.
+// Each node on a shortest path receives its fractional share sigma(s,v)/sigma(s,w) of the paths through it.
+//
+// Parameters:
+//   - state: The single-source data returned by brandesSource.
+//   - source: The source node identifier.
+//   - centrality: The map the dependencies are added to.
+func (state brandesState) accumulate(source graph.Identifier, centrality map[graph.Identifier]float64) {
+	delta := make(map[graph.Identifier]float64, len(state.order))
+
+	// Visit nodes in order of non-increasing distance from the source.
+	for i := len(state.order) - 1; i >= 0; i-- {
+		w := state.order[i]
+
+		for _, v := range state.pred[w] {
+			delta[v] += state.sigma[v] / state.sigma[w] * (1 + delta[w])
+		}
+
+		if w != source {
+			centrality[w] += delta[w]
+		}
+	}
+}
+
+// accumulateEdges adds the dependencies of the source onto every edge of the shortest-path DAG.
+// An edge (v, w) receives the share sigma(s,v)/sigma(s,w) of the paths to w and of those continuing beyond it,
+// so that, summed over all sources, every pair splits one unit of credit among its shortest paths.
+//
+// Parameters:
+//   - state: The single-source data returned by brandesSource.
+//   - g: The graph the state was computed on, used to key undirected edges.
+//   - centrality: The map the edge dependencies are added to, keyed by edgeKey.
+func (state brandesState) accumulateEdges(g *graph.Graph, centrality map[[2]graph.Identifier]float64) {
+	delta := make(map[graph.Identifier]float64, len(state.order))
+
+	// Visit nodes in order of non-increasing distance from the source.
+	for i := len(state.order) - 1; i >= 0; i-- {
+		w := state.order[i]
+
+		for _, v := range state.pred[w] {
+			c := state.sigma[v] / state.sigma[w] * (1 + delta[w])
+
+			centrality[edgeKey(g, v, w)] += c
+			delta[v] += c
+		}
+	}
+}
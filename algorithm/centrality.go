@@ -1,127 +1,405 @@
 package algorithm
 
 import (
+	"context"
+	"fmt"
 	"math"
-	"sync"
 
+	err "github.com/elecbug/go-graphtric/err" // Custom error package
 	"github.com/elecbug/go-graphtric/graph"
 )
 
 // BetweennessCentrality computes the betweenness centrality of each node in the graph for a Unit.
 // Betweenness centrality measures how often a node appears on the shortest paths between pairs of other nodes.
+// It is computed with Brandes' algorithm: when a pair has several shortest paths, each intermediate node
+// receives the fraction of those paths that pass through it.
 //
 // Parameters:
 //   - g: The graph to compute the betweenness centrality for.
 //
 // Returns:
 //   - A map where the keys are node identifiers and the values are the betweenness centrality scores.
+//
+// Notes:
+//   - Scores are divided by (n-1)(n-2), the number of ordered pairs of other nodes, for every graph.
+//     In an undirected graph each unordered pair is counted from both of its ends, which gives the same score
+//     as dividing by the (n-1)(n-2)/2 unordered pairs, so the center of a star scores 1 in both cases.
 func (u *Unit) BetweennessCentrality(g *graph.Graph) map[graph.Identifier]float64 {
-	if !g.Updated() || !u.updated {
-		// Recompute shortest paths if the graph or unit has been updated.
-		u.computePaths(g)
+	centrality, _ := u.BetweennessCentralityContext(context.Background(), g)
+	return centrality
+}
+
+// BetweennessCentralityContext computes the betweenness centrality for a Unit, observing ctx for cancellation.
+//
+// Parameters:
+//   - ctx: The context used to abort the computation.
+//   - g: The graph to compute the betweenness centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the betweenness centrality scores.
+//   - ctx.Err() if the context is cancelled before the computation completes.
+func (u *Unit) BetweennessCentralityContext(ctx context.Context, g *graph.Graph) (map[graph.Identifier]float64, error) {
+	return u.betweennessCentrality(ctx, g, nil)
+}
+
+// BetweennessCentralityProgress computes the betweenness centrality for a Unit, reporting progress as it runs.
+// Progress is measured in ordered node pairs, and is reported once per source node as its pairs complete.
+//
+// Parameters:
+//   - g: The graph to compute the betweenness centrality for.
+//   - progress: Called with the number of processed pairs and the total; passing nil disables reporting.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the betweenness centrality scores.
+func (u *Unit) BetweennessCentralityProgress(g *graph.Graph, progress func(done, total int)) map[graph.Identifier]float64 {
+	centrality, _ := u.betweennessCentrality(context.Background(), g, progress)
+	return centrality
+}
+
+// BetweennessCentralityRaw computes the un-normalized betweenness centrality of each node in the graph for a Unit.
+// The score of a node is the sum, over ordered pairs of other nodes, of the fraction of their shortest paths
+// that pass through it.
+//
+// Parameters:
+//   - g: The graph to compute the betweenness centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the raw path counts.
+//
+// Notes:
+//   - In an undirected graph every unordered pair is counted twice, once from each end.
+//   - BetweennessCentrality normalizes these counts by the number of pairs of other nodes when the graph has
+//     more than two nodes, see BetweennessCentrality.
+func (u *Unit) BetweennessCentralityRaw(g *graph.Graph) map[graph.Identifier]float64 {
+	centrality, _ := u.betweennessCounts(context.Background(), g, nil)
+	return centrality
+}
+
+// BetweennessCentralityWithEndpoints computes the betweenness centrality for a Unit, counting the endpoints
+// of each shortest path as well as its intermediate nodes. This is the inclusive convention of some other tools.
+//
+// Parameters:
+//   - g: The graph to compute the betweenness centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the betweenness centrality scores.
+//
+// Notes:
+//   - Every node additionally receives one count per node it reaches and one per node that reaches it.
+//   - Since every ordered pair of distinct nodes now credits its endpoints, the counts are divided by n(n-1)
+//     instead of (n-1)(n-2) when the graph has more than one node.
+func (u *Unit) BetweennessCentralityWithEndpoints(g *graph.Graph) map[graph.Identifier]float64 {
+	centrality := u.BetweennessCentralityRaw(g)
+
+	for _, id := range sortedIDs(g) {
+		for target := range g.Reachable(id) {
+			if target != id {
+				centrality[id]++
+				centrality[target]++
+			}
+		}
 	}
 
-	centrality := make(map[graph.Identifier]float64)
+	return normalizeBetweennessEndpoints(g, centrality)
+}
 
-	// Initialize centrality scores for all nodes to 0.
-	for i := 0; i < g.NodeCount(); i++ {
-		centrality[graph.Identifier(i)] = 0
+// PercolationCentrality computes the percolation centrality of each node for a Unit, a betweenness variant for
+// spreading processes such as infections or rumors. Each node has a percolation state between 0 and 1, e.g. how
+// infected it is, and the paths from a source count in proportion to the source's share of the total state:
+//
+//	PC(v) = 1/(n-2) · Σ_{s≠v≠t} σ_st(v)/σ_st · x_s / (Σ_i x_i − x_v)
+//
+// Parameters:
+//   - g: The graph to compute the percolation centrality for.
+//   - states: The percolation state x of each node. Missing nodes have state 0.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the percolation centrality scores.
+//
+// Notes:
+//   - It reuses Brandes' dependency accumulation, weighting the dependencies of each source by its state.
+//   - When all states are equal and non-zero, the weight of every source is 1/(n-1), and the scores equal
+//     those of BetweennessCentrality. A node whose state is the only non-zero one, or a graph whose states
+//     are all 0, scores 0.
+func (u *Unit) PercolationCentrality(g *graph.Graph, states map[graph.Identifier]float64) map[graph.Identifier]float64 {
+	ids := sortedIDs(g)
+	n := len(ids)
+
+	totalState := 0.0
+	for _, id := range ids {
+		totalState += states[id]
 	}
 
-	// Count how many times each node appears on the shortest paths.
-	for _, path := range u.shortestPaths {
-		nodes := path.Nodes()
+	centrality := make(map[graph.Identifier]float64, n)
+	for _, id := range ids {
+		centrality[id] = 0
+	}
 
-		for _, n := range nodes {
-			// Exclude the source and target nodes of the path.
-			if n != nodes[0] && n != nodes[len(nodes)-1] {
-				centrality[n]++
+	for _, source := range ids {
+		if states[source] == 0 {
+			continue // The source percolates nothing.
+		}
+
+		dependency := make(map[graph.Identifier]float64, n)
+		brandesSource(g, source).accumulate(source, dependency)
+
+		for v, delta := range dependency {
+			if others := totalState - states[v]; others > 0 {
+				centrality[v] += delta * states[source] / others
 			}
 		}
 	}
 
-	// Normalize the centrality scores.
-	n := g.NodeCount()
 	if n > 2 {
-		for node := range centrality {
-			centrality[node] /= float64((n - 1) * (n - 2))
+		for _, id := range ids {
+			centrality[id] /= float64(n - 2)
 		}
 	}
 
 	return centrality
 }
 
+// GroupBetweenness computes the group betweenness centrality of a set of nodes for a Unit, e.g. a team or a region:
+// the fraction of the shortest paths between nodes outside the group that pass through at least one member.
+// Unlike the sum of the members' individual betweenness, a path through several members is counted once.
+//
+// Parameters:
+//   - g: The graph to compute the group betweenness for.
+//   - group: The identifiers of the group members. Missing and duplicate identifiers are ignored.
+//
+// Returns:
+//   - The average over ordered pairs (s, t) of non-members, with t reachable from s, of the fraction of shortest
+//     s-t paths that visit a member, between 0 and 1; 0 if there are no such pairs.
+//
+// Notes:
+//   - The stored shortest paths keep a single path per pair, which cannot tell how many tied paths avoid
+//     the group. Instead, the shortest paths from every source are counted as in Brandes' algorithm, once in g
+//     and once without the group; paths of the same length that survive the removal avoid every member.
+//   - It costs two single-source searches per non-member, like BetweennessCentrality.
+func (u *Unit) GroupBetweenness(g *graph.Graph, group []graph.Identifier) float64 {
+	member := make(map[graph.Identifier]bool, len(group))
+	for _, id := range group {
+		member[id] = true
+	}
+
+	outside := []graph.Identifier{}
+	for _, id := range sortedIDs(g) {
+		if !member[id] {
+			outside = append(outside, id)
+		}
+	}
+
+	rest, mapping := g.Subgraph(outside)
+
+	total, pairs := 0.0, 0
+
+	for _, s := range outside {
+		all := brandesSource(g, s)
+		avoiding := brandesSource(rest, mapping[s])
+
+		for _, t := range outside {
+			if _, reached := all.dist[t]; !reached || t == s {
+				continue
+			}
+
+			pairs++
+			through := 1.0
+
+			if d, ok := avoiding.dist[mapping[t]]; ok && d == all.dist[t] {
+				through -= avoiding.sigma[mapping[t]] / all.sigma[t]
+			}
+
+			total += through
+		}
+	}
+
+	if pairs == 0 {
+		return 0
+	}
+
+	return total / float64(pairs)
+}
+
+// betweennessCentrality is the shared implementation of the betweenness centrality variants for a Unit.
+func (u *Unit) betweennessCentrality(ctx context.Context, g *graph.Graph, progress func(done, total int)) (map[graph.Identifier]float64, error) {
+	centrality, err := u.betweennessCounts(ctx, g, progress)
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeBetweenness(g, centrality), nil
+}
+
+// betweennessCounts accumulates Brandes' pair dependencies from every source for a Unit.
+func (u *Unit) betweennessCounts(ctx context.Context, g *graph.Graph, progress func(done, total int)) (map[graph.Identifier]float64, error) {
+	ids := sortedIDs(g)
+	total := len(ids) * (len(ids) - 1)
+
+	centrality := make(map[graph.Identifier]float64, len(ids))
+
+	// Initialize centrality scores for all nodes to 0.
+	for _, id := range ids {
+		centrality[id] = 0
+	}
+
+	for done, source := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		brandesSource(g, source).accumulate(source, centrality)
+
+		if progress != nil {
+			progress((done+1)*(len(ids)-1), total)
+		}
+	}
+
+	return centrality, nil
+}
+
 // BetweennessCentrality computes the betweenness centrality of each node in the graph for a ParallelUnit.
 // The computation is performed in parallel for better performance on larger graphs.
+// Like the Unit variant, it uses Brandes' algorithm and credits tied shortest paths fractionally.
 //
 // Parameters:
 //   - g: The graph to compute the betweenness centrality for.
 //
 // Returns:
 //   - A map where the keys are node identifiers and the values are the betweenness centrality scores.
+//
+// Notes:
+//   - Partial results are aggregated in a fixed order, so the scores are bit-identical to those of a Unit
+//     regardless of the number of workers.
 func (pu *ParallelUnit) BetweennessCentrality(g *graph.Graph) map[graph.Identifier]float64 {
-	if !g.Updated() || !pu.updated {
-		// Recompute shortest paths if the graph or unit has been updated.
-		pu.computePaths(g)
-	}
+	centrality, _ := pu.BetweennessCentralityContext(context.Background(), g)
+	return centrality
+}
 
-	centrality := make(map[graph.Identifier]float64)
+// BetweennessCentralityContext computes the betweenness centrality for a ParallelUnit, observing ctx for cancellation.
+// The context is checked between batches of source nodes, so cancellation stops the computation early.
+//
+// Parameters:
+//   - ctx: The context used to abort the computation.
+//   - g: The graph to compute the betweenness centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the betweenness centrality scores.
+//   - ctx.Err() if the context is cancelled before the computation completes.
+func (pu *ParallelUnit) BetweennessCentralityContext(ctx context.Context, g *graph.Graph) (map[graph.Identifier]float64, error) {
+	return pu.betweennessCentrality(ctx, g, nil)
+}
 
-	// Initialize centrality scores for all nodes to 0.
-	for i := 0; i < g.NodeCount(); i++ {
-		centrality[graph.Identifier(i)] = 0
+// BetweennessCentralityProgress computes the betweenness centrality for a ParallelUnit, reporting progress as it runs.
+// Progress is measured in ordered node pairs, and is reported once per source node as its pairs complete.
+//
+// Parameters:
+//   - g: The graph to compute the betweenness centrality for.
+//   - progress: Called with the number of processed pairs and the total; passing nil disables reporting.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the betweenness centrality scores.
+func (pu *ParallelUnit) BetweennessCentralityProgress(g *graph.Graph, progress func(done, total int)) map[graph.Identifier]float64 {
+	centrality, _ := pu.betweennessCentrality(context.Background(), g, progress)
+	return centrality
+}
+
+// BetweennessCentralityRaw computes the un-normalized betweenness centrality of each node in the graph for a ParallelUnit.
+// The score of a node is the sum, over ordered pairs of other nodes, of the fraction of their shortest paths
+// that pass through it.
+//
+// Parameters:
+//   - g: The graph to compute the betweenness centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the raw path counts.
+//
+// Notes:
+//   - BetweennessCentrality divides these counts by (n-1)(n-2) when the graph has more than two nodes.
+func (pu *ParallelUnit) BetweennessCentralityRaw(g *graph.Graph) map[graph.Identifier]float64 {
+	centrality, _ := pu.betweennessCounts(context.Background(), g, nil)
+	return centrality
+}
+
+// ParallelUnit version of BetweennessCentralityWithEndpoints.
+// Computes the inclusive betweenness centrality using parallel computations.
+func (pu *ParallelUnit) BetweennessCentralityWithEndpoints(g *graph.Graph) map[graph.Identifier]float64 {
+	centrality := pu.BetweennessCentralityRaw(g)
+	ids := sortedIDs(g)
+	reached := make([]map[graph.Identifier]bool, len(ids))
+
+	pu.parallelFor(len(ids), func(i int) {
+		reached[i] = g.Reachable(ids[i])
+	})
+
+	for i, id := range ids {
+		for target := range reached[i] {
+			if target != id {
+				centrality[id]++
+				centrality[target]++
+			}
+		}
 	}
 
-	// Define a result type to collect intermediate centrality counts.
-	type result struct {
-		node  graph.Identifier
-		count float64
+	return normalizeBetweennessEndpoints(g, centrality)
+}
+
+// betweennessCentrality is the shared implementation of the betweenness centrality variants for a ParallelUnit.
+func (pu *ParallelUnit) betweennessCentrality(ctx context.Context, g *graph.Graph, progress func(done, total int)) (map[graph.Identifier]float64, error) {
+	centrality, err := pu.betweennessCounts(ctx, g, progress)
+	if err != nil {
+		return nil, err
 	}
 
-	resultChan := make(chan result, g.NodeCount())
-	var wg sync.WaitGroup
+	return normalizeBetweenness(g, centrality), nil
+}
 
-	// Compute centrality scores in parallel.
-	for _, path := range pu.shortestPaths {
-		wg.Add(1)
+// betweennessCounts accumulates Brandes' pair dependencies from every source for a ParallelUnit.
+func (pu *ParallelUnit) betweennessCounts(ctx context.Context, g *graph.Graph, progress func(done, total int)) (map[graph.Identifier]float64, error) {
+	ids := sortedIDs(g)
+	total := len(ids) * (len(ids) - 1)
 
-		go func(path graph.Path) {
-			defer wg.Done()
-			nodes := path.Nodes()
+	centrality := make(map[graph.Identifier]float64, len(ids))
 
-			for _, n := range nodes {
-				// Exclude the source and target nodes of the path.
-				if n != nodes[0] && n != nodes[len(nodes)-1] {
-					resultChan <- result{node: n, count: 1}
-				}
-			}
-		}(path)
+	// Initialize centrality scores for all nodes to 0.
+	for _, id := range ids {
+		centrality[id] = 0
 	}
 
-	// Close the result channel after all goroutines complete.
-	go func() {
-		wg.Wait()
-		close(resultChan)
-	}()
+	// Process one batch of sources per round, so only a batch of dependency maps is held at once.
+	batch := pu.workers()
+	deltas := make([]map[graph.Identifier]float64, batch)
 
-	// Aggregate results from the result channel.
-	for res := range resultChan {
-		centrality[res.node] += res.count
-	}
+	for lo := 0; lo < len(ids); lo += batch {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 
-	// Normalize the centrality scores.
-	n := g.NodeCount()
-	if n > 2 {
-		for node := range centrality {
-			centrality[node] /= float64((n - 1) * (n - 2))
+		hi := min(lo+batch, len(ids))
+
+		pu.parallelFor(hi-lo, func(i int) {
+			source := ids[lo+i]
+			deltas[i] = make(map[graph.Identifier]float64)
+			brandesSource(g, source).accumulate(source, deltas[i])
+		})
+
+		// Add the dependencies in source order, so the sums are bit-identical to those of a Unit.
+		for i := 0; i < hi-lo; i++ {
+			for node, delta := range deltas[i] {
+				centrality[node] += delta
+			}
+
+			if progress != nil {
+				progress((lo+i+1)*(len(ids)-1), total)
+			}
 		}
 	}
 
-	return centrality
+	return centrality, nil
 }
 
 // DegreeCentrality computes the degree centrality of each node in the graph for a Unit.
 // Degree centrality is the number of direct connections a node has to other nodes.
+// For directed graphs both incoming and outgoing edges are counted.
 //
 // Parameters:
 //   - g: The graph to compute the degree centrality for.
@@ -129,92 +407,271 @@ func (pu *ParallelUnit) BetweennessCentrality(g *graph.Graph) map[graph.Identifi
 // Returns:
 //   - A map where the keys are node identifiers and the values are the degree centrality scores.
 func (u *Unit) DegreeCentrality(g *graph.Graph) map[graph.Identifier]float64 {
-	centrality := make(map[graph.Identifier]float64)
+	return normalizeDegree(g, u.DegreeCentralityRaw(g))
+}
 
-	// Initialize centrality scores for all nodes to 0.
-	for i := 0; i < g.NodeCount(); i++ {
-		centrality[graph.Identifier(i)] = 0
+// DegreeCentralityRaw computes the un-normalized degree of each node in the graph for a Unit.
+// For directed graphs both incoming and outgoing edges are counted.
+// A self-loop counts twice, once for each end, matching DegreeSequence.
+//
+// Parameters:
+//   - g: The graph to compute the degrees for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the edge counts.
+//
+// Notes:
+//   - DegreeCentrality divides these counts by (n-1) when the graph has more than one node.
+func (u *Unit) DegreeCentralityRaw(g *graph.Graph) map[graph.Identifier]float64 {
+	centrality := u.outDegrees(g)
+
+	// Add incoming edges for directed graphs; undirected rows already count every edge.
+	if g.Directed() {
+		for node, value := range u.inDegrees(g) {
+			centrality[node] += value
+		}
+	} else {
+		addSelfLoopEnds(g, centrality)
 	}
 
-	// Calculate the degree for each node by counting direct neighbors.
+	return centrality
+}
+
+// DegreeCentrality computes the degree centrality of each node in the graph for a ParallelUnit.
+// The computation is performed in parallel for better performance on larger graphs.
+// For directed graphs both incoming and outgoing edges are counted.
+//
+// Parameters:
+//   - g: The graph to compute the degree centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the degree centrality scores.
+func (pu *ParallelUnit) DegreeCentrality(g *graph.Graph) map[graph.Identifier]float64 {
+	return normalizeDegree(g, pu.DegreeCentralityRaw(g))
+}
+
+// DegreeCentralityRaw computes the un-normalized degree of each node in the graph for a ParallelUnit.
+// For directed graphs both incoming and outgoing edges are counted.
+//
+// Parameters:
+//   - g: The graph to compute the degrees for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the edge counts.
+//
+// Notes:
+//   - DegreeCentrality divides these counts by (n-1) when the graph has more than one node.
+func (pu *ParallelUnit) DegreeCentralityRaw(g *graph.Graph) map[graph.Identifier]float64 {
+	centrality := pu.outDegrees(g)
+
+	// Add incoming edges for directed graphs; undirected rows already count every edge.
+	if g.Directed() {
+		for node, value := range pu.inDegrees(g) {
+			centrality[node] += value
+		}
+	} else {
+		addSelfLoopEnds(g, centrality)
+	}
+
+	return centrality
+}
+
+// WeightedDegreeCentrality computes the weighted degree (node strength) of each node in the graph for a Unit.
+// Node strength is the sum of the weights of the edges incident to a node, rather than their count.
+// For directed graphs both incoming and outgoing edges are summed.
+//
+// Parameters:
+//   - g: The graph to compute the node strengths for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the node strengths.
+func (u *Unit) WeightedDegreeCentrality(g *graph.Graph) map[graph.Identifier]float64 {
+	return u.GeneralizedDegreeCentrality(g, 1)
+}
+
+// GeneralizedDegreeCentrality computes Opsahl's generalized degree centrality of each node for a Unit.
+// It blends the degree k and the strength s of a node as k^(1-alpha) * s^alpha.
+//
+// Parameters:
+//   - g: The graph to compute the centrality for.
+//   - alpha: The tuning parameter; 0 yields the plain degree and 1 yields the strength.
+//     For equal strength, alpha < 1 favors nodes with many edges and alpha > 1 favors nodes with few heavy edges.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the generalized degree scores.
+func (u *Unit) GeneralizedDegreeCentrality(g *graph.Graph, alpha float64) map[graph.Identifier]float64 {
 	matrix := g.ToMatrix()
+	degree := make(map[graph.Identifier]float64)
+	strength := make(map[graph.Identifier]float64)
+
+	for _, id := range g.NodeIDs() {
+		degree[id] = 0
+		strength[id] = 0
+	}
+
+	// Sum the weights of incident edges, skipping missing edges and the diagonal.
 	for i, row := range matrix {
-		for _, value := range row {
-			if value != graph.INF {
-				centrality[graph.Identifier(i)]++
+		for j, value := range row {
+			if i == j || value == graph.INF {
+				continue
+			}
+
+			degree[graph.Identifier(i)]++
+			strength[graph.Identifier(i)] += float64(value.Int())
+
+			// Count incoming edges as well for directed graphs.
+			if g.Directed() {
+				degree[graph.Identifier(j)]++
+				strength[graph.Identifier(j)] += float64(value.Int())
 			}
 		}
 	}
 
-	// Normalize centrality scores by the maximum possible degree (n-1).
-	n := g.NodeCount()
-	if n > 1 {
-		for node := range centrality {
-			centrality[node] /= float64(n - 1)
+	centrality := make(map[graph.Identifier]float64)
+	for node := range degree {
+		if degree[node] == 0 {
+			centrality[node] = 0
+			continue
 		}
+
+		centrality[node] = math.Pow(degree[node], 1-alpha) * math.Pow(strength[node], alpha)
 	}
 
 	return centrality
 }
 
-// DegreeCentrality computes the degree centrality of each node in the graph for a ParallelUnit.
-// The computation is performed in parallel for better performance on larger graphs.
+// OutDegreeCentrality computes the out-degree centrality of each node in the graph for a Unit.
+// Out-degree centrality is the number of edges leaving a node, read from its adjacency list.
 //
 // Parameters:
-//   - g: The graph to compute the degree centrality for.
+//   - g: The graph to compute the out-degree centrality for.
 //
 // Returns:
-//   - A map where the keys are node identifiers and the values are the degree centrality scores.
-func (pu *ParallelUnit) DegreeCentrality(g *graph.Graph) map[graph.Identifier]float64 {
+//   - A map where the keys are node identifiers and the values are the out-degree centrality scores.
+func (u *Unit) OutDegreeCentrality(g *graph.Graph) map[graph.Identifier]float64 {
+	return normalizeDegree(g, u.outDegrees(g))
+}
+
+// outDegrees counts the out-degree of each node in the graph.
+func (u *Unit) outDegrees(g *graph.Graph) map[graph.Identifier]float64 {
 	centrality := make(map[graph.Identifier]float64)
 
-	// Initialize centrality scores for all nodes to 0.
-	for i := 0; i < g.NodeCount(); i++ {
-		centrality[graph.Identifier(i)] = 0
+	// Calculate the degree for each node by counting direct neighbors.
+	for _, id := range g.NodeIDs() {
+		centrality[id] = float64(len(g.Neighbors(id)))
 	}
 
-	matrix := g.ToMatrix()
-	var wg sync.WaitGroup
-	resultChan := make(chan struct {
-		node  graph.Identifier
-		count float64
-	}, g.NodeCount())
+	return centrality
+}
+
+// OutDegreeCentrality computes the out-degree centrality of each node in the graph for a ParallelUnit.
+// The computation is performed in parallel for better performance on larger graphs.
+//
+// Parameters:
+//   - g: The graph to compute the out-degree centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the out-degree centrality scores.
+func (pu *ParallelUnit) OutDegreeCentrality(g *graph.Graph) map[graph.Identifier]float64 {
+	return normalizeDegree(g, pu.outDegrees(g))
+}
+
+// outDegrees counts the out-degree of each node in the graph.
+func (pu *ParallelUnit) outDegrees(g *graph.Graph) map[graph.Identifier]float64 {
+	centrality := make(map[graph.Identifier]float64)
+
+	ids := g.NodeIDs()
+	counts := make([]float64, len(ids))
 
 	// Compute degree centrality in parallel.
-	for i := 0; i < len(matrix); i++ {
-		wg.Add(1)
-
-		go func(nodeIndex int) {
-			defer wg.Done()
-			count := 0.0
-			for _, value := range matrix[nodeIndex] {
-				if value != graph.INF {
-					count++
-				}
+	pu.parallelFor(len(ids), func(i int) {
+		counts[i] = float64(len(g.Neighbors(ids[i])))
+	})
+
+	for i, id := range ids {
+		centrality[id] = counts[i]
+	}
+
+	return centrality
+}
+
+// InDegreeCentrality computes the in-degree centrality of each node in the graph for a Unit.
+// In-degree centrality is the number of edges entering a node, counted over all adjacency lists.
+//
+// Parameters:
+//   - g: The graph to compute the in-degree centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the in-degree centrality scores.
+func (u *Unit) InDegreeCentrality(g *graph.Graph) map[graph.Identifier]float64 {
+	return normalizeDegree(g, u.inDegrees(g))
+}
+
+// inDegrees counts the in-degree of each node in the graph.
+// Edges to removed nodes, which RemoveNode leaves behind, are not counted.
+func (u *Unit) inDegrees(g *graph.Graph) map[graph.Identifier]float64 {
+	centrality := make(map[graph.Identifier]float64)
+
+	// Initialize centrality scores for all nodes to 0.
+	for _, id := range g.NodeIDs() {
+		centrality[id] = 0
+	}
+
+	// Calculate the degree for each node by counting incoming edges.
+	for _, id := range g.NodeIDs() {
+		for _, neighbor := range g.Neighbors(id) {
+			if _, exists := centrality[neighbor]; exists {
+				centrality[neighbor]++
 			}
-			resultChan <- struct {
-				node  graph.Identifier
-				count float64
-			}{node: graph.Identifier(nodeIndex), count: count}
-		}(i)
+		}
 	}
 
-	// Close the result channel after all goroutines complete.
-	go func() {
-		wg.Wait()
-		close(resultChan)
-	}()
+	return centrality
+}
 
-	// Aggregate results from the result channel.
-	for res := range resultChan {
-		centrality[res.node] = res.count
+// InDegreeCentrality computes the in-degree centrality of each node in the graph for a ParallelUnit.
+// The computation is performed in parallel for better performance on larger graphs.
+//
+// Parameters:
+//   - g: The graph to compute the in-degree centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the in-degree centrality scores.
+func (pu *ParallelUnit) InDegreeCentrality(g *graph.Graph) map[graph.Identifier]float64 {
+	return normalizeDegree(g, pu.inDegrees(g))
+}
+
+// inDegrees counts the in-degree of each node in the graph.
+// Edges to removed nodes, which RemoveNode leaves behind, are not counted.
+func (pu *ParallelUnit) inDegrees(g *graph.Graph) map[graph.Identifier]float64 {
+	centrality := make(map[graph.Identifier]float64)
+
+	// Initialize centrality scores for all nodes to 0.
+	for _, id := range g.NodeIDs() {
+		centrality[id] = 0
 	}
 
-	// Normalize centrality scores by the maximum possible degree (n-1).
-	n := g.NodeCount()
-	if n > 1 {
-		for node := range centrality {
-			centrality[node] /= float64(n - 1)
+	ids := g.NodeIDs()
+	chunks := pu.chunkRanges(len(ids))
+	partials := make([]map[graph.Identifier]float64, len(chunks))
+
+	// Count incoming edges in parallel, one chunk of adjacency lists per worker.
+	pu.parallelFor(len(chunks), func(c int) {
+		partials[c] = make(map[graph.Identifier]float64)
+
+		for _, id := range ids[chunks[c][0]:chunks[c][1]] {
+			for _, neighbor := range g.Neighbors(id) {
+				partials[c][neighbor]++
+			}
+		}
+	})
+
+	// Aggregate the partial counts, skipping edges to removed nodes.
+	for _, partial := range partials {
+		for node, count := range partial {
+			if _, exists := centrality[node]; exists {
+				centrality[node] += count
+			}
 		}
 	}
 
@@ -229,19 +686,60 @@ func (pu *ParallelUnit) DegreeCentrality(g *graph.Graph) map[graph.Identifier]fl
 //
 // Returns:
 //   - A map where the keys are node identifiers and the values are the eigenvector centrality scores.
+//
+// Notes:
+//   - If the iteration does not converge within maxIter, the last scores are returned silently;
+//     use EigenvectorCentralityChecked to detect this.
 func (u *Unit) EigenvectorCentrality(g *graph.Graph, maxIter int, tol float64) map[graph.Identifier]float64 {
-	matrix := g.ToMatrix()
-	n := len(matrix)
+	centrality, _ := u.EigenvectorCentralityContext(context.Background(), g, maxIter, tol)
+	return centrality
+}
 
-	// Initialize centrality scores with 1/n
-	centrality := make([]float64, n)
-	for i := 0; i < n; i++ {
-		centrality[i] = 1.0 / float64(n)
+// EigenvectorCentralityContext computes the eigenvector centrality for a Unit, observing ctx for cancellation.
+// The context is checked once per power iteration.
+//
+// Parameters:
+//   - ctx: The context used to abort the computation.
+//   - g: The graph to compute the eigenvector centrality for.
+//   - maxIter: The maximum number of power iterations.
+//   - tol: The convergence tolerance on the L1 change between iterations.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the eigenvector centrality scores.
+//   - ctx.Err() if the context is cancelled before the computation completes.
+func (u *Unit) EigenvectorCentralityContext(ctx context.Context, g *graph.Graph, maxIter int, tol float64) (map[graph.Identifier]float64, error) {
+	result, _, _, err := u.eigenvectorCentrality(ctx, g, maxIter, tol)
+	return result, err
+}
+
+// EigenvectorCentralityChecked computes the eigenvector centrality for a Unit and reports whether it converged.
+// Power iteration does not converge when the dominant eigenvalue is not unique in magnitude, e.g. on
+// bipartite graphs, where the scores oscillate between two vectors instead of settling.
+//
+// Parameters:
+//   - g: The graph to compute the eigenvector centrality for.
+//   - maxIter: The maximum number of power iterations.
+//   - tol: The convergence tolerance on the L1 change between iterations.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the eigenvector centrality scores.
+//     If the iteration did not converge, these are the scores after the last iteration.
+//   - An error reporting the final L1 change if the change did not drop below tol within maxIter iterations.
+func (u *Unit) EigenvectorCentralityChecked(g *graph.Graph, maxIter int, tol float64) (map[graph.Identifier]float64, error) {
+	result, residual, converged, _ := u.eigenvectorCentrality(context.Background(), g, maxIter, tol)
+	if !converged {
+		return result, notConverged(maxIter, residual)
 	}
 
-	for iter := 0; iter < maxIter; iter++ {
-		newCentrality := make([]float64, n)
+	return result, nil
+}
+
+// eigenvectorCentrality runs the power iteration for a Unit.
+func (u *Unit) eigenvectorCentrality(ctx context.Context, g *graph.Graph, maxIter int, tol float64) (map[graph.Identifier]float64, float64, bool, error) {
+	matrix := g.ToMatrix()
+	n := len(matrix)
 
+	return powerIteration(ctx, n, maxIter, tol, func(centrality, newCentrality []float64) {
 		// Update centrality scores
 		for i := 0; i < n; i++ {
 			for j := 0; j < n; j++ {
@@ -250,38 +748,7 @@ func (u *Unit) EigenvectorCentrality(g *graph.Graph, maxIter int, tol float64) m
 				}
 			}
 		}
-
-		// Normalize the new centrality scores
-		norm := 0.0
-		for _, value := range newCentrality {
-			norm += value * value
-		}
-		norm = math.Sqrt(norm)
-
-		for i := 0; i < n; i++ {
-			newCentrality[i] /= norm
-		}
-
-		// Check for convergence
-		diff := 0.0
-		for i := 0; i < n; i++ {
-			diff += math.Abs(newCentrality[i] - centrality[i])
-		}
-
-		if diff < tol {
-			break
-		}
-
-		centrality = newCentrality
-	}
-
-	// Convert to map for output
-	result := make(map[graph.Identifier]float64)
-	for i := 0; i < n; i++ {
-		result[graph.Identifier(i)] = centrality[i]
-	}
-
-	return result
+	})
 }
 
 // EigenvectorCentrality computes the eigenvector centrality of each node in the graph for a ParallelUnit.
@@ -293,35 +760,77 @@ func (u *Unit) EigenvectorCentrality(g *graph.Graph, maxIter int, tol float64) m
 // Returns:
 //   - A map where the keys are node identifiers and the values are the eigenvector centrality scores.
 func (pu *ParallelUnit) EigenvectorCentrality(g *graph.Graph, maxIter int, tol float64) map[graph.Identifier]float64 {
+	centrality, _ := pu.EigenvectorCentralityContext(context.Background(), g, maxIter, tol)
+	return centrality
+}
+
+// EigenvectorCentralityContext computes the eigenvector centrality for a ParallelUnit, observing ctx for cancellation.
+// The context is checked once per power iteration.
+//
+// Parameters:
+//   - ctx: The context used to abort the computation.
+//   - g: The graph to compute the eigenvector centrality for.
+//   - maxIter: The maximum number of power iterations.
+//   - tol: The convergence tolerance on the L1 change between iterations.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the eigenvector centrality scores.
+//   - ctx.Err() if the context is cancelled before the computation completes.
+func (pu *ParallelUnit) EigenvectorCentralityContext(ctx context.Context, g *graph.Graph, maxIter int, tol float64) (map[graph.Identifier]float64, error) {
+	result, _, _, err := pu.eigenvectorCentrality(ctx, g, maxIter, tol)
+	return result, err
+}
+
+// ParallelUnit version of EigenvectorCentralityChecked.
+// Computes the eigenvector centrality using parallel computations and reports whether it converged.
+func (pu *ParallelUnit) EigenvectorCentralityChecked(g *graph.Graph, maxIter int, tol float64) (map[graph.Identifier]float64, error) {
+	result, residual, converged, _ := pu.eigenvectorCentrality(context.Background(), g, maxIter, tol)
+	if !converged {
+		return result, notConverged(maxIter, residual)
+	}
+
+	return result, nil
+}
+
+// eigenvectorCentrality runs the power iteration for a ParallelUnit.
+func (pu *ParallelUnit) eigenvectorCentrality(ctx context.Context, g *graph.Graph, maxIter int, tol float64) (map[graph.Identifier]float64, float64, bool, error) {
 	matrix := g.ToMatrix()
 	n := len(matrix)
 
+	return powerIteration(ctx, n, maxIter, tol, func(centrality, newCentrality []float64) {
+		// Update centrality scores in parallel
+		pu.parallelFor(n, func(node int) {
+			for j := 0; j < n; j++ {
+				if matrix[node][j] != graph.INF {
+					newCentrality[node] += float64(matrix[node][j].Int()) * centrality[j]
+				}
+			}
+		})
+	})
+}
+
+// powerIteration repeatedly applies update to a vector of n scores, normalizing it to unit L2 norm,
+// until the L1 change between iterations drops below tol or maxIter iterations have run.
+// update must add the product of the matrix and centrality into the zeroed newCentrality.
+// If the product vanishes, the scores become zero instead of NaN.
+//
+// Returns the scores keyed by index, the last L1 change, whether it converged, and ctx.Err() if cancelled.
+func powerIteration(ctx context.Context, n, maxIter int, tol float64, update func(centrality, newCentrality []float64)) (map[graph.Identifier]float64, float64, bool, error) {
 	// Initialize centrality scores with 1/n
 	centrality := make([]float64, n)
 	for i := 0; i < n; i++ {
 		centrality[i] = 1.0 / float64(n)
 	}
 
-	for iter := 0; iter < maxIter; iter++ {
-		newCentrality := make([]float64, n)
+	residual, converged := math.Inf(1), false
 
-		var wg sync.WaitGroup
-
-		// Update centrality scores in parallel
-		for i := 0; i < n; i++ {
-			wg.Add(1)
-
-			go func(node int) {
-				defer wg.Done()
-				for j := 0; j < n; j++ {
-					if matrix[node][j] != graph.INF {
-						newCentrality[node] += float64(matrix[node][j].Int()) * centrality[j]
-					}
-				}
-			}(i)
+	for iter := 0; iter < maxIter; iter++ {
+		if err := ctx.Err(); err != nil {
+			return nil, residual, false, err
 		}
 
-		wg.Wait()
+		newCentrality := make([]float64, n)
+		update(centrality, newCentrality)
 
 		// Normalize the new centrality scores
 		norm := 0.0
@@ -330,8 +839,12 @@ func (pu *ParallelUnit) EigenvectorCentrality(g *graph.Graph, maxIter int, tol f
 		}
 		norm = math.Sqrt(norm)
 
-		for i := 0; i < n; i++ {
-			newCentrality[i] /= norm
+		// An all-zero product, e.g. on a graph without edges or an acyclic directed graph, has nothing
+		// to normalize; dividing by zero would turn every score into NaN, so the zero vector is kept.
+		if norm > 0 {
+			for i := 0; i < n; i++ {
+				newCentrality[i] /= norm
+			}
 		}
 
 		// Check for convergence
@@ -340,7 +853,10 @@ func (pu *ParallelUnit) EigenvectorCentrality(g *graph.Graph, maxIter int, tol f
 			diff += math.Abs(newCentrality[i] - centrality[i])
 		}
 
+		residual = diff
+
 		if diff < tol {
+			converged = true
 			break
 		}
 
@@ -353,5 +869,60 @@ func (pu *ParallelUnit) EigenvectorCentrality(g *graph.Graph, maxIter int, tol f
 		result[graph.Identifier(i)] = centrality[i]
 	}
 
-	return result
+	return result, residual, converged, nil
+}
+
+// notConverged builds the error returned when an iteration stops at maxIter with the given residual.
+func notConverged(maxIter int, residual float64) error {
+	return err.NotConverged(fmt.Sprintf("%d iterations, residual %g", maxIter, residual))
+}
+
+// addSelfLoopEnds counts the second end of every self-loop of an undirected graph, whose rows list it only once.
+func addSelfLoopEnds(g *graph.Graph, centrality map[graph.Identifier]float64) {
+	for _, id := range g.NodeIDs() {
+		for _, neighbor := range g.Neighbors(id) {
+			if neighbor == id {
+				centrality[id]++
+			}
+		}
+	}
+}
+
+// normalizeBetweenness divides raw betweenness counts by the number of ordered pairs of other nodes, (n-1)(n-2).
+// This single divisor is already right for undirected graphs: Brandes' accumulation counts each unordered pair
+// from both of its ends, and halving those counts would cancel out against dividing by the (n-1)(n-2)/2
+// unordered pairs instead.
+func normalizeBetweenness(g *graph.Graph, centrality map[graph.Identifier]float64) map[graph.Identifier]float64 {
+	n := g.NodeCount()
+	if n > 2 {
+		for node := range centrality {
+			centrality[node] /= float64((n - 1) * (n - 2))
+		}
+	}
+
+	return centrality
+}
+
+// normalizeBetweennessEndpoints divides inclusive betweenness counts by the number of ordered node pairs, n(n-1).
+func normalizeBetweennessEndpoints(g *graph.Graph, centrality map[graph.Identifier]float64) map[graph.Identifier]float64 {
+	n := g.NodeCount()
+	if n > 1 {
+		for node := range centrality {
+			centrality[node] /= float64(n * (n - 1))
+		}
+	}
+
+	return centrality
+}
+
+// normalizeDegree divides raw degree counts by the maximum possible degree, (n-1).
+func normalizeDegree(g *graph.Graph, centrality map[graph.Identifier]float64) map[graph.Identifier]float64 {
+	n := g.NodeCount()
+	if n > 1 {
+		for node := range centrality {
+			centrality[node] /= float64(n - 1)
+		}
+	}
+
+	return centrality
 }
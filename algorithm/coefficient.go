@@ -1,7 +1,7 @@
 package algorithm
 
 import (
-	"sync"
+	"math"
 
 	"github.com/elecbug/go-graphtric/graph"
 )
@@ -16,6 +16,9 @@ import (
 // Returns:
 //   - A map where the keys are node identifiers and the values are the local clustering coefficients.
 //   - The global clustering coefficient as a float64.
+//
+// Notes:
+//   - Self-loops are ignored: a node is never its own neighbor, see graph.AllowSelfLoops.
 func (u *Unit) ClusteringCoefficient(g *graph.Graph) (map[graph.Identifier]float64, float64) {
 	matrix := g.ToMatrix() // Get adjacency matrix representation of the graph.
 	n := len(matrix)       // Number of nodes in the graph.
@@ -30,7 +33,7 @@ func (u *Unit) ClusteringCoefficient(g *graph.Graph) (map[graph.Identifier]float
 
 		// Identify neighbors of the current node.
 		for i := 0; i < n; i++ {
-			if matrix[v][i] != graph.INF && matrix[v][i] > 0 {
+			if i != v && matrix[v][i] != graph.INF && matrix[v][i] > 0 {
 				neighbors = append(neighbors, i)
 			}
 		}
@@ -81,63 +84,43 @@ func (pu *ParallelUnit) ClusteringCoefficient(g *graph.Graph) (map[graph.Identif
 	localCoeffs := make(map[graph.Identifier]float64)
 	globalSum := float64(0)
 
-	// Type to store intermediate results from goroutines.
-	type result struct {
-		node  graph.Identifier
-		value float64
-	}
-
-	resultChan := make(chan result, n) // Channel for goroutine results.
-	var wg sync.WaitGroup              // WaitGroup to synchronize goroutines.
-
-	// Launch a goroutine for each node to compute its local clustering coefficient.
-	for v := 0; v < n; v++ {
-		wg.Add(1)
-		go func(node int) {
-			defer wg.Done()
+	values := make([]float64, n)
 
-			neighbors := []int{}
+	// Compute the local clustering coefficient of each node on the worker pool.
+	pu.parallelFor(n, func(node int) {
+		neighbors := []int{}
 
-			// Identify neighbors of the current node.
-			for i := 0; i < n; i++ {
-				if matrix[node][i] != graph.INF && matrix[node][i] > 0 {
-					neighbors = append(neighbors, i)
-				}
+		// Identify neighbors of the current node.
+		for i := 0; i < n; i++ {
+			if i != node && matrix[node][i] != graph.INF && matrix[node][i] > 0 {
+				neighbors = append(neighbors, i)
 			}
+		}
 
-			k := len(neighbors) // Degree of the node.
-			if k < 2 {
-				// If a node has fewer than 2 neighbors, its clustering coefficient is 0.
-				resultChan <- result{node: graph.Identifier(node), value: 0.0}
-				return
-			}
+		k := len(neighbors) // Degree of the node.
+		if k < 2 {
+			// If a node has fewer than 2 neighbors, its clustering coefficient is 0.
+			return
+		}
 
-			// Count the number of edges between neighbors.
-			e := 0
-			for i := 0; i < k; i++ {
-				for j := i + 1; j < k; j++ {
-					if matrix[neighbors[i]][neighbors[j]] != graph.INF && matrix[neighbors[i]][neighbors[j]] > 0 {
-						e++
-					}
+		// Count the number of edges between neighbors.
+		e := 0
+		for i := 0; i < k; i++ {
+			for j := i + 1; j < k; j++ {
+				if matrix[neighbors[i]][neighbors[j]] != graph.INF && matrix[neighbors[i]][neighbors[j]] > 0 {
+					e++
 				}
 			}
+		}
 
-			// Compute the local clustering coefficient.
-			Cv := float64(2*e) / float64(k*(k-1))
-			resultChan <- result{node: graph.Identifier(node), value: Cv}
-		}(v)
-	}
-
-	// Close the result channel after all goroutines complete.
-	go func() {
-		wg.Wait()
-		close(resultChan)
-	}()
+		// Compute the local clustering coefficient.
+		values[node] = float64(2*e) / float64(k*(k-1))
+	})
 
-	// Aggregate results from goroutines.
-	for res := range resultChan {
-		localCoeffs[res.node] = res.value
-		globalSum += res.value
+	// Aggregate results in node order.
+	for node, value := range values {
+		localCoeffs[graph.Identifier(node)] = value
+		globalSum += value
 	}
 
 	// Compute the global clustering coefficient (average of local coefficients).
@@ -146,51 +129,120 @@ func (pu *ParallelUnit) ClusteringCoefficient(g *graph.Graph) (map[graph.Identif
 	return localCoeffs, globalCoeff
 }
 
-// RichClubCoefficient computes the rich club coefficient for a given threshold degree k.
-// This coefficient measures how well nodes with degree >= k are connected to each other.
+// WeightedClusteringCoefficient computes the local clustering coefficient of each node following Onnela et al.
+// Each triangle around a node contributes the geometric mean of its three edge weights, scaled by the largest
+// edge weight of the graph, instead of counting 1:
+//
+//	C(v) = 2 / (k(k-1)) * Σ (ŵ(v,i) * ŵ(v,j) * ŵ(i,j))^(1/3),  ŵ = w / max(w)
 //
 // Parameters:
-//   - g: The graph for which the rich club coefficient is computed.
-//   - k: The degree threshold.
+//   - g: The graph for which the clustering coefficients are computed. Weights are read from ToMatrix.
 //
 // Returns:
-//   - The rich club coefficient as a float64.
-func (u *Unit) RichClubCoefficient(g *graph.Graph, k int) float64 {
-	matrix := g.ToMatrix() // Get adjacency matrix representation of the graph.
-	n := len(matrix)       // Number of nodes in the graph.
+//   - A map where the keys are node identifiers and the values are the weighted local clustering coefficients.
+//
+// Notes:
+//   - Edge weights are treated as tie strengths, so heavier edges make a triangle count more.
+//   - On unweighted graphs every ŵ is 1 and the result equals the local coefficients of ClusteringCoefficient.
+//   - Nodes with fewer than two neighbors have a coefficient of 0. Self-loops are ignored, also for max(w).
+func WeightedClusteringCoefficient(g *graph.Graph) map[graph.Identifier]float64 {
+	matrix := g.ToMatrix()
+	n := len(matrix)
+
+	// Find the largest edge weight to scale the weights into [0, 1].
+	maxWeight := 0.0
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j && matrix[i][j] != graph.INF {
+				maxWeight = math.Max(maxWeight, float64(matrix[i][j]))
+			}
+		}
+	}
 
-	// Identify nodes with degree >= k
-	nodes := []int{}
-	for v := 0; v < n; v++ {
-		degree := 0
+	scaled := func(i, j int) float64 {
+		return float64(matrix[i][j]) / maxWeight
+	}
+
+	localCoeffs := make(map[graph.Identifier]float64, g.NodeCount())
+
+	for _, id := range g.NodeIDs() {
+		v := int(id)
+		neighbors := []int{}
+
+		// Identify neighbors of the current node.
 		for i := 0; i < n; i++ {
-			if matrix[v][i] != graph.INF && matrix[v][i] > 0 {
-				degree++
+			if i != v && matrix[v][i] != graph.INF && matrix[v][i] > 0 {
+				neighbors = append(neighbors, i)
 			}
 		}
-		if degree >= k {
-			nodes = append(nodes, v)
+
+		k := len(neighbors)
+		if k < 2 {
+			localCoeffs[id] = 0.0
+			continue
 		}
-	}
 
-	Nk := len(nodes) // Number of nodes with degree >= k
-	if Nk < 2 {
-		// If there are fewer than 2 nodes, the rich club coefficient is undefined (0).
-		return 0.0
-	}
+		// Sum the geometric means of the weights of the triangles through v.
+		sum := 0.0
+		for i := 0; i < k; i++ {
+			for j := i + 1; j < k; j++ {
+				a, b := neighbors[i], neighbors[j]
 
-	// Count the number of edges between these nodes
-	Ek := 0
-	for i := 0; i < Nk; i++ {
-		for j := i + 1; j < Nk; j++ {
-			if matrix[nodes[i]][nodes[j]] != graph.INF && matrix[nodes[i]][nodes[j]] > 0 {
-				Ek++
+				if matrix[a][b] != graph.INF && matrix[a][b] > 0 {
+					sum += math.Cbrt(scaled(v, a) * scaled(v, b) * scaled(a, b))
+				}
 			}
 		}
+
+		localCoeffs[id] = 2 * sum / float64(k*(k-1))
 	}
 
-	// Compute the rich club coefficient
-	return float64(2*Ek) / float64(Nk*(Nk-1))
+	return localCoeffs
+}
+
+// RichClubCoefficient computes the rich club coefficient for a given threshold degree k.
+// This coefficient measures how densely the nodes with degree greater than k are connected to each other,
+// as the fraction of the possible edges among them that are present.
+//
+// Parameters:
+//   - g: The graph for which the rich club coefficient is computed.
+//   - k: The degree threshold.
+//
+// Returns:
+//   - The rich club coefficient as a float64, or 0 if fewer than two nodes have degree greater than k.
+//
+// Notes:
+//   - Degrees ignore self-loops and count zero-weight edges. For directed graphs the degree is the sum of
+//     the in- and out-degree, and the coefficient is relative to the Nk(Nk-1) possible directed edges.
+func (u *Unit) RichClubCoefficient(g *graph.Graph, k int) float64 {
+	matrix := g.ToMatrix() // Get adjacency matrix representation of the graph.
+
+	return richClubCoefficient(richClubDegrees(matrix, g.Directed()), k, func(nodes []int) int {
+		return richClubEdges(matrix, nodes, 0, len(nodes))
+	})
+}
+
+// RichClubProfile computes the rich club coefficient for every degree threshold of the graph.
+//
+// Parameters:
+//   - g: The graph for which the rich club profile is computed.
+//
+// Returns:
+//   - A map from each threshold k, for which at least two nodes have degree greater than k, to the
+//     rich club coefficient at that threshold, see RichClubCoefficient.
+func (u *Unit) RichClubProfile(g *graph.Graph) map[int]float64 {
+	matrix := g.ToMatrix()
+	degrees := richClubDegrees(matrix, g.Directed())
+
+	profile := make(map[int]float64)
+
+	for _, k := range richClubThresholds(degrees) {
+		profile[k] = richClubCoefficient(degrees, k, func(nodes []int) int {
+			return richClubEdges(matrix, nodes, 0, len(nodes))
+		})
+	}
+
+	return profile
 }
 
 // RichClubCoefficient computes the rich club coefficient for a given threshold degree k using a ParallelUnit.
@@ -201,75 +253,150 @@ func (u *Unit) RichClubCoefficient(g *graph.Graph, k int) float64 {
 //   - k: The degree threshold.
 //
 // Returns:
-//   - The rich club coefficient as a float64.
+//   - The rich club coefficient as a float64, or 0 if fewer than two nodes have degree greater than k.
 func (pu *ParallelUnit) RichClubCoefficient(g *graph.Graph, k int) float64 {
 	matrix := g.ToMatrix() // Get adjacency matrix representation of the graph.
-	n := len(matrix)       // Number of nodes in the graph.
 
-	// Identify nodes with degree >= k in parallel
-	nodesChan := make(chan int, n)
-	var wg sync.WaitGroup
+	return richClubCoefficient(pu.richClubDegrees(matrix, g.Directed()), k, func(nodes []int) int {
+		return pu.richClubEdges(matrix, nodes)
+	})
+}
 
-	for v := 0; v < n; v++ {
-		wg.Add(1)
-		go func(node int) {
-			defer wg.Done()
-			degree := 0
-			for i := 0; i < n; i++ {
-				if matrix[node][i] != graph.INF && matrix[node][i] > 0 {
-					degree++
+// ParallelUnit version of RichClubProfile.
+// Computes the rich club profile using parallel computations.
+func (pu *ParallelUnit) RichClubProfile(g *graph.Graph) map[int]float64 {
+	matrix := g.ToMatrix()
+	degrees := pu.richClubDegrees(matrix, g.Directed())
+
+	thresholds := richClubThresholds(degrees)
+	values := make([]float64, len(thresholds))
+
+	// One threshold per job, each counting its edges sequentially.
+	pu.parallelFor(len(thresholds), func(i int) {
+		values[i] = richClubCoefficient(degrees, thresholds[i], func(nodes []int) int {
+			return richClubEdges(matrix, nodes, 0, len(nodes))
+		})
+	})
+
+	profile := make(map[int]float64, len(thresholds))
+	for i, k := range thresholds {
+		profile[k] = values[i]
+	}
+
+	return profile
+}
+
+// richClubDegrees returns the degree of every node of the matrix, ignoring the diagonal.
+// For directed graphs the in- and out-degree are summed.
+func richClubDegrees(matrix graph.Matrix, directed bool) []int {
+	degrees := make([]int, len(matrix))
+
+	for v := range matrix {
+		for i := range matrix {
+			if i != v && matrix[v][i] != graph.INF {
+				degrees[v]++
+				if directed {
+					degrees[i]++
 				}
 			}
-			if degree >= k {
-				nodesChan <- node
+		}
+	}
+
+	return degrees
+}
+
+// richClubDegrees computes the degrees of richClubDegrees in parallel, one row per job.
+func (pu *ParallelUnit) richClubDegrees(matrix graph.Matrix, directed bool) []int {
+	n := len(matrix)
+	degrees := make([]int, n)
+
+	pu.parallelFor(n, func(v int) {
+		for i := 0; i < n; i++ {
+			if i == v {
+				continue
 			}
-		}(v)
+
+			// Each job only writes its own row, reading the column for incoming edges.
+			if matrix[v][i] != graph.INF {
+				degrees[v]++
+			}
+			if directed && matrix[i][v] != graph.INF {
+				degrees[v]++
+			}
+		}
+	})
+
+	return degrees
+}
+
+// richClubThresholds returns, in ascending order, the thresholds k for which at least two nodes have degree greater than k.
+func richClubThresholds(degrees []int) []int {
+	// The second largest degree bounds the thresholds.
+	first, second := -1, -1
+	for _, d := range degrees {
+		if d > first {
+			first, second = d, first
+		} else if d > second {
+			second = d
+		}
+	}
+
+	thresholds := []int{}
+	for k := 0; k < second; k++ {
+		thresholds = append(thresholds, k)
 	}
 
-	// Close channel after goroutines finish
-	go func() {
-		wg.Wait()
-		close(nodesChan)
-	}()
+	return thresholds
+}
 
-	// Collect nodes with degree >= k
+// richClubCoefficient selects the nodes with degree greater than k and divides the number of edges among them,
+// as counted by count, by the number of ordered pairs of distinct selected nodes.
+func richClubCoefficient(degrees []int, k int, count func(nodes []int) int) float64 {
 	nodes := []int{}
-	for node := range nodesChan {
-		nodes = append(nodes, node)
+	for v, degree := range degrees {
+		if degree > k {
+			nodes = append(nodes, v)
+		}
 	}
 
-	Nk := len(nodes) // Number of nodes with degree >= k
+	Nk := len(nodes) // Number of nodes with degree > k
 	if Nk < 2 {
 		// If there are fewer than 2 nodes, the rich club coefficient is undefined (0).
 		return 0.0
 	}
 
-	// Count the number of edges between these nodes in parallel
-	EkChan := make(chan int, Nk*Nk)
-	for i := 0; i < Nk; i++ {
-		for j := i + 1; j < Nk; j++ {
-			wg.Add(1)
-			go func(node1, node2 int) {
-				defer wg.Done()
-				if matrix[node1][node2] != graph.INF && matrix[node1][node2] > 0 {
-					EkChan <- 1
-				}
-			}(nodes[i], nodes[j])
+	// Undirected edges appear twice in the matrix, so both cases divide by the ordered pairs.
+	return float64(count(nodes)) / float64(Nk*(Nk-1))
+}
+
+// richClubEdges counts the matrix entries from the nodes at positions [start, end) to the other selected nodes.
+func richClubEdges(matrix graph.Matrix, nodes []int, start, end int) int {
+	edges := 0
+
+	for i := start; i < end; i++ {
+		for j := range nodes {
+			if i != j && matrix[nodes[i]][nodes[j]] != graph.INF {
+				edges++
+			}
 		}
 	}
 
-	// Close edge channel after goroutines finish
-	go func() {
-		wg.Wait()
-		close(EkChan)
-	}()
+	return edges
+}
+
+// richClubEdges counts the edges of richClubEdges in parallel, one row per job.
+func (pu *ParallelUnit) richClubEdges(matrix graph.Matrix, nodes []int) int {
+	rowEdges := make([]int, len(nodes))
+
+	pu.parallelFor(len(nodes), func(i int) {
+		rowEdges[i] = richClubEdges(matrix, nodes, i, i+1)
+	})
 
 	// Sum up the edges
-	Ek := 0
-	for edge := range EkChan {
-		Ek += edge
+	edges := 0
+	for _, count := range rowEdges {
+		edges += count
 	}
 
-	// Compute the rich club coefficient
-	return float64(2*Ek) / float64(Nk*(Nk-1))
+	return edges
 }
@@ -0,0 +1,87 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// GirvanNewman detects communities by repeatedly removing the edge with the highest betweenness.
+// After each removal the edge betweenness is recomputed, until the graph splits into the requested
+// number of connected components or runs out of edges.
+//
+// Parameters:
+//   - g: The graph to partition. It is not modified; the removals are applied to a working copy.
+//   - targetCommunities: The number of communities to split the graph into.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are community labels.
+//     Labels start at 0 and are ordered by the smallest identifier in each community.
+//
+// Notes:
+//   - Each round recomputes the edge betweenness with Brandes' algorithm, one search per node.
+//   - Ties between edges with equal betweenness are broken by the smallest edge key.
+func GirvanNewman(g *graph.Graph, targetCommunities int) map[graph.Identifier]int {
+	work := g.Clone()
+	u := NewUnit()
+
+	parts := ConnectedComponents(work)
+
+	for len(parts) < targetCommunities && work.EdgeCount() > 0 {
+		var best [2]graph.Identifier
+		bestScore := -1.0
+
+		for edge, score := range u.EdgeBetweenness(work) {
+			if score > bestScore || (score == bestScore && lessPair(edge, best)) {
+				best, bestScore = edge, score
+			}
+		}
+
+		work.RemoveEdge(best[0], best[1])
+		parts = ConnectedComponents(work)
+	}
+
+	labels := make(map[graph.Identifier]int, g.NodeCount())
+	for label, part := range parts {
+		for _, node := range part {
+			labels[node] = label
+		}
+	}
+
+	return labels
+}
+
+// emptyCopy creates a graph of the given type with the same nodes as g but no edges.
+// Node identifiers and names are preserved; identifiers of removed nodes stay unused.
+func emptyCopy(g *graph.Graph, graphType graph.GraphType) *graph.Graph {
+	ids := sortedIDs(g)
+	result := graph.NewGraph(graphType, len(ids))
+
+	if len(ids) == 0 {
+		return result
+	}
+
+	// Identifiers are assigned sequentially, so fill the gaps with placeholders and remove them afterwards.
+	for id := graph.Identifier(0); id <= ids[len(ids)-1]; id++ {
+		if node, e := g.FindNode(id); e == nil {
+			result.AddNode(node.Name)
+		} else {
+			result.AddNode("")
+		}
+	}
+
+	for id := graph.Identifier(0); id <= ids[len(ids)-1]; id++ {
+		if _, e := g.FindNode(id); e != nil {
+			result.RemoveNode(id)
+		}
+	}
+
+	return result
+}
+
+// lessPair reports whether the pair a sorts before the pair b.
+func lessPair(a, b [2]graph.Identifier) bool {
+	if a[0] != b[0] {
+		return a[0] < b[0]
+	}
+
+	return a[1] < b[1]
+}
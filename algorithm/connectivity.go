@@ -1 +1,190 @@
 package algorithm
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// ConnectedComponents partitions the nodes of an undirected graph into connected components.
+// Each component is found by a breadth-first search over the adjacency lists.
+//
+// Parameters:
+//   - g: The graph to partition. It is expected to be undirected; for directed graphs the
+//     search only follows outgoing edges, so the result depends on the visiting order.
+//
+// Returns:
+//   - A slice of components, each a slice of node identifiers in ascending order.
+//     Components are ordered by their smallest identifier.
+func ConnectedComponents(g *graph.Graph) [][]graph.Identifier {
+	return components(g, func(id graph.Identifier) []graph.Identifier {
+		return g.Neighbors(id)
+	})
+}
+
+// WeaklyConnectedComponents partitions the nodes of a directed graph into weakly connected components.
+// Edges are treated as undirected, so two nodes share a component if they are linked ignoring direction.
+// This complements StronglyConnectedComponents, which respects edge direction.
+//
+// Parameters:
+//   - g: The graph to partition. For undirected graphs the result equals ConnectedComponents.
+//
+// Returns:
+//   - A slice of components covering every node, each a slice of node identifiers in ascending order.
+//     Components are ordered by their smallest identifier.
+func WeaklyConnectedComponents(g *graph.Graph) [][]graph.Identifier {
+	neighbors := undirectedNeighbors(g)
+
+	return components(g, func(id graph.Identifier) []graph.Identifier {
+		result := make([]graph.Identifier, 0, len(neighbors[id]))
+		for next := range neighbors[id] {
+			result = append(result, next)
+		}
+
+		return result
+	})
+}
+
+// components groups nodes reachable from each other through the given neighbor function.
+//
+// Parameters:
+//   - g: The graph whose nodes are grouped.
+//   - neighbors: Returns the nodes adjacent to a node.
+//
+// Returns:
+//   - A slice of components, each sorted in ascending order, ordered by their smallest identifier.
+func components(g *graph.Graph, neighbors func(graph.Identifier) []graph.Identifier) [][]graph.Identifier {
+	visited := make(map[graph.Identifier]bool, g.NodeCount())
+	result := [][]graph.Identifier{}
+
+	// Start from nodes in ascending order so each component begins at its smallest identifier.
+	for _, start := range sortedIDs(g) {
+		if visited[start] {
+			continue
+		}
+
+		component := []graph.Identifier{}
+		queue := []graph.Identifier{start}
+		visited[start] = true
+
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			component = append(component, node)
+
+			for _, next := range neighbors(node) {
+				if !visited[next] {
+					visited[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+
+		sort.Slice(component, func(i, j int) bool { return component[i] < component[j] })
+		result = append(result, component)
+	}
+
+	return result
+}
+
+// StronglyConnectedComponents partitions the nodes of a directed graph into strongly connected components
+// using Tarjan's algorithm. Every node of a component can reach every other node of the same component.
+//
+// Parameters:
+//   - g: The graph to partition. For undirected graphs the result equals ConnectedComponents.
+//
+// Returns:
+//   - A slice of components, each a slice of node identifiers in ascending order.
+//     Components are ordered by their smallest identifier.
+func StronglyConnectedComponents(g *graph.Graph) [][]graph.Identifier {
+	index := make(map[graph.Identifier]int, g.NodeCount())
+	lowLink := make(map[graph.Identifier]int, g.NodeCount())
+	onStack := make(map[graph.Identifier]bool, g.NodeCount())
+	stack := []graph.Identifier{}
+	result := [][]graph.Identifier{}
+	counter := 0
+
+	var connect func(v graph.Identifier)
+	connect = func(v graph.Identifier) {
+		index[v] = counter
+		lowLink[v] = counter
+		counter++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, w := range g.Neighbors(v) {
+			if _, seen := index[w]; !seen {
+				connect(w)
+				lowLink[v] = min(lowLink[v], lowLink[w])
+			} else if onStack[w] {
+				lowLink[v] = min(lowLink[v], index[w])
+			}
+		}
+
+		// v is the root of a component: pop its members off the stack.
+		if lowLink[v] == index[v] {
+			component := []graph.Identifier{}
+
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+
+				if w == v {
+					break
+				}
+			}
+
+			sort.Slice(component, func(i, j int) bool { return component[i] < component[j] })
+			result = append(result, component)
+		}
+	}
+
+	for _, id := range sortedIDs(g) {
+		if _, seen := index[id]; !seen {
+			connect(id)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i][0] < result[j][0] })
+
+	return result
+}
+
+// CondensationGraph collapses every strongly connected component of a directed graph into a single node.
+// The result is acyclic by construction, so it can be topologically sorted even if g has cycles.
+//
+// Parameters:
+//   - g: The graph to condense.
+//
+// Returns:
+//   - A directed unweighted graph whose node i stands for the i-th component, with an edge between two
+//     super-nodes whenever an edge joins their members in g. It has no self-loops.
+//   - The members of each super-node, as returned by StronglyConnectedComponents.
+func CondensationGraph(g *graph.Graph) (*graph.Graph, [][]graph.Identifier) {
+	components := StronglyConnectedComponents(g)
+	result := graph.NewGraph(graph.DirectedUnweighted, len(components))
+
+	componentOf := make(map[graph.Identifier]graph.Identifier, g.NodeCount())
+
+	for i, component := range components {
+		result.AddNode(fmt.Sprintf("%d", i))
+
+		for _, node := range component {
+			componentOf[node] = graph.Identifier(i)
+		}
+	}
+
+	for _, from := range sortedIDs(g) {
+		for _, to := range g.Neighbors(from) {
+			// Edges inside a component vanish; duplicate edges between components are rejected.
+			if componentOf[from] != componentOf[to] {
+				result.AddEdge(componentOf[from], componentOf[to])
+			}
+		}
+	}
+
+	return result, components
+}
@@ -0,0 +1,175 @@
+package algorithm
+
+import (
+	"math"
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// CurrentFlowBetweenness computes the current-flow betweenness centrality of each node in the graph for a Unit.
+// The graph is treated as an electrical network of unit resistors: for every pair (s, t) a unit current is
+// injected at s and extracted at t, and each other node is credited with the current flowing through it.
+// Unlike geodesic betweenness, every path carries some flow, so the measure follows random walks between pairs.
+//
+// Parameters:
+//   - g: The graph to compute the centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the current-flow betweenness scores,
+//     normalized by the number of unordered pairs of other nodes, (n-1)(n-2)/2.
+//
+// Notes:
+//   - Edge weights and directions are ignored; every linked pair of nodes is joined by a single unit resistor.
+//   - Node potentials are read from the inverse of the grounded Laplacian of each connected component.
+//     Pairs in different components carry no current.
+//   - The cost is O(n³) for the inversion plus O(n² * m) for the pairs, so it suits graphs of a few hundred nodes.
+func (u *Unit) CurrentFlowBetweenness(g *graph.Graph) map[graph.Identifier]float64 {
+	neighbors := undirectedNeighbors(g)
+	centrality := make(map[graph.Identifier]float64, g.NodeCount())
+
+	for _, id := range g.NodeIDs() {
+		centrality[id] = 0
+	}
+
+	for _, component := range WeaklyConnectedComponents(g) {
+		k := len(component)
+		if k < 3 {
+			continue
+		}
+
+		index := make(map[graph.Identifier]int, k)
+		for i, id := range component {
+			index[id] = i
+		}
+
+		// Sorted adjacency lists keep the floating-point sums reproducible.
+		adjacency := make([][]int, k)
+		for i, id := range component {
+			for next := range neighbors[id] {
+				adjacency[i] = append(adjacency[i], index[next])
+			}
+			sort.Ints(adjacency[i])
+		}
+
+		potentials := groundedInverse(adjacency)
+
+		for s := 0; s < k; s++ {
+			for t := s + 1; t < k; t++ {
+				for v := 0; v < k; v++ {
+					if v == s || v == t {
+						continue
+					}
+
+					// The throughput of v is half the absolute current over its incident resistors.
+					pv := potentials[v][s] - potentials[v][t]
+					flow := 0.0
+
+					for _, w := range adjacency[v] {
+						flow += math.Abs(pv - (potentials[w][s] - potentials[w][t]))
+					}
+
+					centrality[component[v]] += flow / 2
+				}
+			}
+		}
+	}
+
+	n := g.NodeCount()
+	if n > 2 {
+		for node := range centrality {
+			centrality[node] /= float64((n-1)*(n-2)) / 2
+		}
+	}
+
+	return centrality
+}
+
+// groundedInverse returns the inverse of the Laplacian of a connected unit-resistor network grounded at node 0.
+// Entry [i][j] is the potential of node i when a unit current enters at j and leaves at the ground,
+// so the potentials of a unit s-t flow are column s minus column t.
+//
+// Parameters:
+//   - adjacency: The sorted neighbor positions of each node of the network.
+//
+// Returns:
+//   - A k x k matrix whose row and column 0 are zero.
+func groundedInverse(adjacency [][]int) [][]float64 {
+	k := len(adjacency)
+
+	// Drop the row and column of the grounded node, which makes the Laplacian invertible.
+	reduced := make([][]float64, k-1)
+	for i := 1; i < k; i++ {
+		reduced[i-1] = make([]float64, k-1)
+		reduced[i-1][i-1] = float64(len(adjacency[i]))
+
+		for _, j := range adjacency[i] {
+			if j != 0 {
+				reduced[i-1][j-1]--
+			}
+		}
+	}
+
+	inverse := invertMatrix(reduced)
+
+	result := make([][]float64, k)
+	result[0] = make([]float64, k)
+	for i := 1; i < k; i++ {
+		result[i] = append([]float64{0}, inverse[i-1]...)
+	}
+
+	return result
+}
+
+// invertMatrix inverts a non-singular square matrix by Gauss-Jordan elimination with partial pivoting.
+//
+// Parameters:
+//   - m: The matrix to invert. It is not modified.
+//
+// Returns:
+//   - The inverse of m.
+func invertMatrix(m [][]float64) [][]float64 {
+	n := len(m)
+
+	// Augment m with the identity matrix.
+	a := make([][]float64, n)
+	for i := range m {
+		a[i] = make([]float64, 2*n)
+		copy(a[i], m[i])
+		a[i][n+i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(a[r][col]) > math.Abs(a[pivot][col]) {
+				pivot = r
+			}
+		}
+
+		a[col], a[pivot] = a[pivot], a[col]
+
+		p := a[col][col]
+		for j := range a[col] {
+			a[col][j] /= p
+		}
+
+		for r := 0; r < n; r++ {
+			if r == col || a[r][col] == 0 {
+				continue
+			}
+
+			f := a[r][col]
+			for j := range a[r] {
+				a[r][j] -= f * a[col][j]
+			}
+		}
+	}
+
+	inverse := make([][]float64, n)
+	for i := range a {
+		inverse[i] = a[i][n:]
+	}
+
+	return inverse
+}
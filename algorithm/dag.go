@@ -0,0 +1,377 @@
+package algorithm
+
+import (
+	"sort"
+
+	err "github.com/elecbug/go-graphtric/err" // Custom error package
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// TopologicalSort orders the nodes of a directed acyclic graph so that every edge points forward.
+// It uses Kahn's algorithm, which doubles as the cycle detector for the DAG algorithms.
+//
+// Parameters:
+//   - g: The graph to sort.
+//
+// Returns:
+//   - The node identifiers in topological order; among nodes that become ready together the smaller identifier comes first.
+//   - An error if the graph contains a cycle. Undirected graphs with at least one edge are always cyclic.
+func TopologicalSort(g *graph.Graph) ([]graph.Identifier, error) {
+	ids := sortedIDs(g)
+	indegree := make(map[graph.Identifier]int, len(ids))
+
+	for _, id := range ids {
+		for _, to := range g.Neighbors(id) {
+			indegree[to]++
+		}
+	}
+
+	queue := []graph.Identifier{}
+	for _, id := range ids {
+		if indegree[id] == 0 {
+			queue = append(queue, id)
+		}
+	}
+
+	order := make([]graph.Identifier, 0, len(ids))
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		order = append(order, node)
+
+		for _, next := range g.Neighbors(node) {
+			indegree[next]--
+			if indegree[next] == 0 {
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	if len(order) < len(ids) {
+		// Nodes never released by Kahn's algorithm lie on or behind a cycle.
+		for _, id := range ids {
+			if indegree[id] > 0 {
+				return nil, err.CyclicGraph(id.String())
+			}
+		}
+	}
+
+	return order, nil
+}
+
+// LongestPath finds the maximum-weight path between two nodes of a directed acyclic graph.
+// Nodes are relaxed in topological order, keeping the heaviest distance instead of the lightest,
+// which is the basis of critical-path and scheduling analysis.
+//
+// Parameters:
+//   - g: The directed acyclic graph. Unweighted edges count as weight 1.
+//   - from: The starting node identifier.
+//   - to: The ending node identifier.
+//
+// Returns:
+//   - A graph.Path containing the longest path and its total weight.
+//     If no path exists, the Path has distance INF and an empty node sequence.
+//   - The total weight of the path as an int64, or -1 if no path exists.
+//   - An error if either node does not exist or the graph contains a cycle.
+func LongestPath(g *graph.Graph, from, to graph.Identifier) (graph.Path, int64, error) {
+	if _, e := g.FindNode(from); e != nil {
+		return graph.Path{}, -1, e
+	}
+	if _, e := g.FindNode(to); e != nil {
+		return graph.Path{}, -1, e
+	}
+
+	order, e := TopologicalSort(g)
+	if e != nil {
+		return graph.Path{}, -1, e
+	}
+
+	dist := map[graph.Identifier]int64{from: 0}
+	prev := make(map[graph.Identifier]graph.Identifier)
+
+	for _, node := range order {
+		d, ok := dist[node]
+		if !ok {
+			continue
+		}
+
+		for _, edge := range g.NeighborEdges(node) {
+			candidate := d + int64(edge.Distance())
+
+			if old, seen := dist[edge.To()]; !seen || candidate > old {
+				dist[edge.To()] = candidate
+				prev[edge.To()] = node
+			}
+		}
+	}
+
+	length, ok := dist[to]
+	if !ok {
+		return *graph.NewPath(graph.INF, []graph.Identifier{}), -1, nil
+	}
+
+	return *graph.NewPath(graph.Distance(length), tracePath(prev, from, to)), length, nil
+}
+
+// CriticalPath finds the critical path of a project network whose edges are tasks and whose weights are task durations.
+// A forward pass over the topological order computes the earliest start time of every node and
+// a backward pass computes the latest start time that does not delay the project.
+// Tasks with zero slack (latest minus earliest start) form the critical path.
+//
+// Parameters:
+//   - g: The directed acyclic project network. Unweighted edges count as duration 1.
+//
+// Returns:
+//   - The node identifiers along the critical path, from a start node to an end node.
+//     When several critical paths exist, the one through the smallest identifiers is returned.
+//   - The total duration of the project.
+//   - An error if the graph contains a cycle.
+func CriticalPath(g *graph.Graph) ([]graph.Identifier, int64, error) {
+	order, e := TopologicalSort(g)
+	if e != nil {
+		return nil, 0, e
+	}
+
+	if len(order) == 0 {
+		return nil, 0, nil
+	}
+
+	earliest := make(map[graph.Identifier]int64, len(order))
+	var duration int64
+
+	for _, node := range order {
+		for _, edge := range g.NeighborEdges(node) {
+			earliest[edge.To()] = max(earliest[edge.To()], earliest[node]+int64(edge.Distance()))
+		}
+
+		duration = max(duration, earliest[node])
+	}
+
+	latest := make(map[graph.Identifier]int64, len(order))
+	for _, node := range order {
+		latest[node] = duration
+	}
+
+	for i := len(order) - 1; i >= 0; i-- {
+		node := order[i]
+
+		for _, edge := range g.NeighborEdges(node) {
+			latest[node] = min(latest[node], latest[edge.To()]-int64(edge.Distance()))
+		}
+	}
+
+	// critical reports whether the task (from, to) has zero slack.
+	critical := func(from graph.Identifier, edge graph.Edge) bool {
+		return earliest[from] == latest[from] && earliest[from]+int64(edge.Distance()) == latest[edge.To()] &&
+			earliest[edge.To()] == latest[edge.To()]
+	}
+
+	var start graph.Identifier
+	for _, id := range sortedIDs(g) {
+		if earliest[id] == 0 && latest[id] == 0 {
+			start = id
+			break
+		}
+	}
+
+	path := []graph.Identifier{start}
+
+	for node := start; earliest[node] < duration; {
+		next, found := graph.Identifier(0), false
+
+		for _, edge := range g.NeighborEdges(node) {
+			if critical(node, edge) && (!found || edge.To() < next) {
+				next, found = edge.To(), true
+			}
+		}
+
+		node = next
+		path = append(path, node)
+	}
+
+	return path, duration, nil
+}
+
+// PERT analyzes a project network whose task durations are uncertain, using the three-point estimates of the
+// Program Evaluation and Review Technique. Each task (edge) is given the expected duration (o + 4m + p) / 6 and
+// the variance ((p - o) / 6)²; the critical path is the longest path under the expected durations.
+//
+// Parameters:
+//   - g: The directed acyclic project network. Edge weights are ignored.
+//   - optimistic: The optimistic duration o of each task, keyed by its {from, to} pair.
+//   - mostLikely: The most likely duration m of each task, keyed by its {from, to} pair.
+//   - pessimistic: The pessimistic duration p of each task, keyed by its {from, to} pair.
+//
+// Returns:
+//   - The expected duration of the project, the length of the critical path.
+//   - The variance of the project duration, the sum of the task variances along the critical path.
+//   - The node identifiers along the critical path, from a start node to an end node; nil if the graph is empty.
+//   - An error if the graph contains a cycle.
+//
+// Notes:
+//   - Estimates missing from a map count as 0.
+//   - Task durations are assumed independent, so the variances add up. When several critical paths exist,
+//     the one ending at the smallest identifier is returned.
+func PERT(g *graph.Graph, optimistic, mostLikely, pessimistic map[[2]graph.Identifier]float64) (float64, float64, []graph.Identifier, error) {
+	order, e := TopologicalSort(g)
+	if e != nil {
+		return 0, 0, nil, e
+	}
+
+	if len(order) == 0 {
+		return 0, 0, nil, nil
+	}
+
+	earliest := make(map[graph.Identifier]float64, len(order))
+	prev := make(map[graph.Identifier]graph.Identifier)
+
+	// expected returns the expected duration of the task (from, to).
+	expected := func(from, to graph.Identifier) float64 {
+		key := [2]graph.Identifier{from, to}
+		return (optimistic[key] + 4*mostLikely[key] + pessimistic[key]) / 6
+	}
+
+	for _, node := range order {
+		for _, to := range g.Neighbors(node) {
+			if candidate := earliest[node] + expected(node, to); candidate > earliest[to] {
+				earliest[to] = candidate
+				prev[to] = node
+			}
+		}
+	}
+
+	end := order[0]
+	for _, id := range sortedIDs(g) {
+		if earliest[id] > earliest[end] {
+			end = id
+		}
+	}
+
+	path := []graph.Identifier{end}
+	for node, ok := prev[end]; ok; node, ok = prev[node] {
+		path = append(path, node)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	variance := 0.0
+	for i := 1; i < len(path); i++ {
+		key := [2]graph.Identifier{path[i-1], path[i]}
+		spread := (pessimistic[key] - optimistic[key]) / 6
+		variance += spread * spread
+	}
+
+	return earliest[end], variance, path, nil
+}
+
+// FeedbackArcSetApprox finds a small set of edges whose removal makes a directed graph acyclic,
+// using the greedy ordering heuristic of Eades, Lin, and Smyth.
+// Nodes are arranged in a linear order by repeatedly moving sinks to the back, sources to the front, and
+// otherwise the node with the largest out-degree minus in-degree to the front; the edges pointing
+// backward in that order form the feedback arc set.
+//
+// Parameters:
+//   - g: The directed graph to make acyclic. Edge weights are ignored.
+//
+// Returns:
+//   - The feedback edges as {from, to} pairs, sorted. Removing them leaves a graph that TopologicalSort accepts;
+//     the set is empty for a graph that is already acyclic.
+//
+// Notes:
+//   - Finding a minimum feedback arc set is NP-hard; the heuristic runs in O(n² + m) and usually comes close.
+//   - Ties are broken toward the smallest identifier, so the result is deterministic.
+//   - For undirected graphs every edge is a 2-cycle, so one direction of each edge is returned.
+//   - A self-loop is a cycle on its own, so every self-loop is part of the set.
+func FeedbackArcSetApprox(g *graph.Graph) [][2]graph.Identifier {
+	ids := sortedIDs(g)
+	remaining := make(map[graph.Identifier]bool, len(ids))
+	in := make(map[graph.Identifier]int, len(ids))
+	out := make(map[graph.Identifier]int, len(ids))
+	predecessors := make(map[graph.Identifier][]graph.Identifier, len(ids))
+
+	for _, id := range ids {
+		remaining[id] = true
+
+		for _, to := range g.Neighbors(id) {
+			out[id]++
+			in[to]++
+			predecessors[to] = append(predecessors[to], id)
+		}
+	}
+
+	// remove takes a node out of the remaining graph and updates the degrees of its neighbors.
+	remove := func(node graph.Identifier) {
+		delete(remaining, node)
+
+		for _, to := range g.Neighbors(node) {
+			in[to]--
+		}
+		for _, from := range predecessors[node] {
+			out[from]--
+		}
+	}
+
+	front, back := []graph.Identifier{}, []graph.Identifier{}
+
+	for len(remaining) > 0 {
+		progress := true
+
+		for progress {
+			progress = false
+
+			for _, id := range ids {
+				if !remaining[id] {
+					continue
+				}
+
+				switch {
+				case out[id] == 0:
+					back = append(back, id)
+					remove(id)
+					progress = true
+				case in[id] == 0:
+					front = append(front, id)
+					remove(id)
+					progress = true
+				}
+			}
+		}
+
+		best, found := graph.Identifier(0), false
+		for _, id := range ids {
+			if remaining[id] && (!found || out[id]-in[id] > out[best]-in[best]) {
+				best, found = id, true
+			}
+		}
+
+		if found {
+			front = append(front, best)
+			remove(best)
+		}
+	}
+
+	// Sinks were collected from the back, so they are appended in reverse.
+	position := make(map[graph.Identifier]int, len(ids))
+	for _, id := range front {
+		position[id] = len(position)
+	}
+	for i := len(back) - 1; i >= 0; i-- {
+		position[back[i]] = len(position)
+	}
+
+	result := [][2]graph.Identifier{}
+	for _, id := range ids {
+		for _, to := range g.Neighbors(id) {
+			if to == id || position[to] < position[id] {
+				result = append(result, [2]graph.Identifier{id, to})
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return lessPair(result[i], result[j]) })
+
+	return result
+}
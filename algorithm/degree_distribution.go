@@ -0,0 +1,59 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// DegreeDistribution counts how many nodes have each degree.
+// It is the usual first look at the structure of a network, e.g. to spot the heavy tail of a scale-free graph.
+//
+// Parameters:
+//   - g: The graph to analyze.
+//
+// Returns:
+//   - A map from each degree that occurs to the number of nodes with that degree.
+//
+// Notes:
+//   - Degrees are those of DegreeSequence: in-degree plus out-degree for directed graphs.
+//     Self-loops are never stored, so no diagonal entry is counted.
+func DegreeDistribution(g *graph.Graph) map[int]int {
+	distribution := make(map[int]int)
+
+	for _, degree := range g.DegreeSequence() {
+		distribution[degree]++
+	}
+
+	return distribution
+}
+
+// DegreeDistributionCDF computes the cumulative degree distribution of the graph.
+//
+// Parameters:
+//   - g: The graph to analyze.
+//
+// Returns:
+//   - A map from each degree that occurs to the fraction of nodes whose degree is at most that value.
+//     The largest degree maps to 1. The map is empty if the graph has no nodes.
+func DegreeDistributionCDF(g *graph.Graph) map[int]float64 {
+	distribution := DegreeDistribution(g)
+	n := g.NodeCount()
+
+	degrees := make([]int, 0, len(distribution))
+	for degree := range distribution {
+		degrees = append(degrees, degree)
+	}
+
+	sort.Ints(degrees)
+
+	cdf := make(map[int]float64, len(degrees))
+	cumulative := 0
+
+	for _, degree := range degrees {
+		cumulative += distribution[degree]
+		cdf[degree] = float64(cumulative) / float64(n)
+	}
+
+	return cdf
+}
@@ -1,6 +1,8 @@
 package algorithm
 
 import (
+	"math"
+
 	"github.com/elecbug/go-graphtric/graph"
 )
 
@@ -12,6 +14,8 @@ import (
 //
 // Returns:
 //   - A graph.Path representing the longest shortest path in the graph.
+//     A graph without a path between two distinct nodes, such as an empty or single-node graph,
+//     yields an empty path of distance INF.
 //
 // Notes:
 //   - If the graph or the Unit has been updated, shortest paths are recomputed.
@@ -21,8 +25,8 @@ func (u *Unit) Diameter(g *graph.Graph) graph.Path {
 		u.computePaths(g)
 	}
 
-	// The diameter corresponds to the last (longest) path in the sorted shortestPaths slice.
-	return u.shortestPaths[len(u.shortestPaths)-1]
+	// The diameter corresponds to the last (longest) path in the sorted order of the cached paths.
+	return u.longestPath()
 }
 
 // Diameter computes the diameter of the graph for a ParallelUnit.
@@ -32,6 +36,8 @@ func (u *Unit) Diameter(g *graph.Graph) graph.Path {
 //
 // Returns:
 //   - A graph.Path representing the longest shortest path in the graph.
+//     A graph without a path between two distinct nodes, such as an empty or single-node graph,
+//     yields an empty path of distance INF.
 //
 // Notes:
 //   - If the graph or the ParallelUnit has been updated, shortest paths are recomputed in parallel.
@@ -41,6 +47,53 @@ func (pu *ParallelUnit) Diameter(g *graph.Graph) graph.Path {
 		pu.computePaths(g)
 	}
 
-	// The diameter corresponds to the last (longest) path in the sorted shortestPaths slice.
-	return pu.shortestPaths[len(pu.shortestPaths)-1]
+	// The diameter corresponds to the last (longest) path in the sorted order of the cached paths.
+	return pu.longestPath()
+}
+
+// EffectiveDiameter computes the effective diameter of the graph for a Unit: the smallest distance within which
+// the given fraction of the reachable node pairs lie. Unlike Diameter, a few far outliers do not change it.
+//
+// Parameters:
+//   - g: The graph to compute the effective diameter for.
+//   - percentile: The fraction of reachable pairs to cover, e.g. 0.9. Values are clamped to [0, 1];
+//     1 gives the diameter.
+//
+// Returns:
+//   - The smallest distance d such that at least the given fraction of the ordered reachable pairs are at
+//     distance d or less, or 0 if no pair is reachable.
+//
+// Notes:
+//   - If the graph or the Unit has been updated, shortest paths are recomputed.
+func (u *Unit) EffectiveDiameter(g *graph.Graph, percentile float64) float64 {
+	if !g.Updated() || !u.updated {
+		// Recompute shortest paths if the graph or unit has been updated.
+		u.computePaths(g)
+	}
+
+	return effectiveDiameter(u.sortedDistances(), percentile)
+}
+
+// ParallelUnit version of EffectiveDiameter.
+// Computes the effective diameter, recomputing shortest paths in parallel if needed.
+func (pu *ParallelUnit) EffectiveDiameter(g *graph.Graph, percentile float64) float64 {
+	if !g.Updated() || !pu.updated {
+		// Recompute shortest paths if the graph or unit has been updated.
+		pu.computePaths(g)
+	}
+
+	return effectiveDiameter(pu.sortedDistances(), percentile)
+}
+
+// effectiveDiameter returns the smallest distance covering the given fraction of the ascending distances.
+func effectiveDiameter(distances []graph.Distance, percentile float64) float64 {
+	if len(distances) == 0 {
+		return 0
+	}
+
+	// The first ceil(percentile·N) distances are covered; at least one is needed.
+	covered := int(math.Ceil(max(0, min(1, percentile)) * float64(len(distances))))
+	covered = max(1, covered)
+
+	return float64(distances[covered-1])
 }
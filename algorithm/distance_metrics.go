@@ -0,0 +1,500 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// DistanceMetricsResult bundles the distance-based metrics computed by DistanceMetrics.
+//
+// Fields:
+//   - Closeness: The closeness centrality of each node, see ClosenessCentrality.
+//   - Harmonic: The harmonic centrality of each node, see HarmonicCentrality.
+//   - Eccentricity: The eccentricity of each node, see Eccentricity.
+//   - AverageShortestPathLength: The average shortest path length of the graph, see AverageShortestPathLength.
+type DistanceMetricsResult struct {
+	Closeness                 map[graph.Identifier]float64        // Closeness centrality per node.
+	Harmonic                  map[graph.Identifier]float64        // Harmonic centrality per node.
+	Eccentricity              map[graph.Identifier]graph.Distance // Eccentricity per node.
+	AverageShortestPathLength float64                             // Mean distance over all reachable pairs.
+}
+
+// distanceSums accumulates the per-source distance statistics that all distance-based metrics derive from.
+type distanceSums struct {
+	total      map[graph.Identifier]graph.Distance // Sum of the distances from each source.
+	reciprocal map[graph.Identifier]float64        // Sum of the inverse distances from each source.
+	farthest   map[graph.Identifier]graph.Distance // Largest distance from each source.
+	reached    map[graph.Identifier]int            // Number of nodes reachable from each source.
+}
+
+// newDistanceSums creates an empty accumulator.
+func newDistanceSums() *distanceSums {
+	return &distanceSums{
+		total:      make(map[graph.Identifier]graph.Distance),
+		reciprocal: make(map[graph.Identifier]float64),
+		farthest:   make(map[graph.Identifier]graph.Distance),
+		reached:    make(map[graph.Identifier]int),
+	}
+}
+
+// add records a shortest path under its source node.
+func (s *distanceSums) add(path graph.Path) {
+	source, distance := path.Nodes()[0], path.Distance()
+
+	s.total[source] += distance
+	s.reached[source]++
+
+	if distance > 0 {
+		s.reciprocal[source] += 1 / float64(distance)
+	}
+
+	if distance > s.farthest[source] {
+		s.farthest[source] = distance
+	}
+}
+
+// merge adds the statistics of another accumulator to this one.
+func (s *distanceSums) merge(other *distanceSums) {
+	for source, count := range other.reached {
+		s.total[source] += other.total[source]
+		s.reciprocal[source] += other.reciprocal[source]
+		s.reached[source] += count
+		s.farthest[source] = max(s.farthest[source], other.farthest[source])
+	}
+}
+
+// result derives the metrics of every node of the graph from the accumulated statistics.
+func (s *distanceSums) result(g *graph.Graph) DistanceMetricsResult {
+	ids := g.NodeIDs()
+	n := len(ids)
+
+	result := DistanceMetricsResult{
+		Closeness:    make(map[graph.Identifier]float64, n),
+		Harmonic:     make(map[graph.Identifier]float64, n),
+		Eccentricity: make(map[graph.Identifier]graph.Distance, n),
+	}
+
+	var totalDistance graph.Distance = 0
+	var pairCount int
+
+	for _, id := range ids {
+		result.Closeness[id] = 0
+		result.Harmonic[id] = 0
+		result.Eccentricity[id] = s.farthest[id]
+
+		if s.reached[id] > 0 && s.total[id] > 0 {
+			result.Closeness[id] = float64(s.reached[id]) / float64(s.total[id])
+		}
+
+		if n > 1 {
+			result.Harmonic[id] = s.reciprocal[id] / float64(n-1)
+		}
+
+		totalDistance += s.total[id]
+		pairCount += s.reached[id]
+	}
+
+	if pairCount > 0 {
+		result.AverageShortestPathLength = float64(totalDistance) / float64(pairCount)
+	}
+
+	return result
+}
+
+// DistanceMetrics computes closeness, harmonic centrality, eccentricity, and the average shortest path length
+// in a single pass over the stored shortest paths for a Unit.
+//
+// Parameters:
+//   - g: The graph to compute the metrics for.
+//
+// Returns:
+//   - A DistanceMetricsResult holding the same values as the individual functions.
+//
+// Notes:
+//   - Calling the individual functions walks the stored paths once per metric; prefer this function
+//     when several of them are needed on a large graph.
+func (u *Unit) DistanceMetrics(g *graph.Graph) DistanceMetricsResult {
+	return u.distanceSums(g).result(g)
+}
+
+// distanceSums accumulates the per-source distance statistics of the stored paths for a Unit.
+func (u *Unit) distanceSums(g *graph.Graph) *distanceSums {
+	if !g.Updated() || !u.updated {
+		// Recompute shortest paths if the graph or unit has been updated.
+		u.computePaths(g)
+	}
+
+	sums := newDistanceSums()
+	u.forEachPath(sums.add)
+
+	return sums
+}
+
+// DistanceMetrics computes closeness, harmonic centrality, eccentricity, and the average shortest path length
+// in a single pass over the stored shortest paths for a ParallelUnit.
+// The stored paths are split into chunks that are accumulated in parallel.
+//
+// Parameters:
+//   - g: The graph to compute the metrics for.
+//
+// Returns:
+//   - A DistanceMetricsResult holding the same values as the individual functions.
+func (pu *ParallelUnit) DistanceMetrics(g *graph.Graph) DistanceMetricsResult {
+	if !g.Updated() || !pu.updated {
+		// Recompute shortest paths if the graph or unit has been updated.
+		pu.computePaths(g)
+	}
+
+	chunks := pu.chunkRanges(pu.pathSlots())
+	partials := make([]*distanceSums, len(chunks))
+
+	pu.parallelFor(len(chunks), func(c int) {
+		partials[c] = newDistanceSums()
+		pu.forEachPathIn(chunks[c][0], chunks[c][1], partials[c].add)
+	})
+
+	// Aggregate the partial sums in chunk order.
+	sums := newDistanceSums()
+	for _, partial := range partials {
+		sums.merge(partial)
+	}
+
+	return sums.result(g)
+}
+
+// ClosenessCentrality computes the closeness centrality of each node for a Unit.
+// The closeness of a node is the number of nodes it reaches divided by the sum of its distances to them.
+//
+// Parameters:
+//   - g: The graph to compute the closeness centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the closeness scores.
+//
+// Notes:
+//   - Distances are the total weights of the stored shortest paths, measured from each node outward.
+//   - Only reachable nodes are counted, so on disconnected graphs nodes of small components can score high.
+//     Nodes that reach no other node score 0.
+func (u *Unit) ClosenessCentrality(g *graph.Graph) map[graph.Identifier]float64 {
+	return u.DistanceMetrics(g).Closeness
+}
+
+// ClosenessCentralityWF computes the closeness centrality of each node for a Unit with the Wasserman-Faust
+// correction for disconnected graphs. The closeness over the r nodes a node reaches is scaled by the fraction
+// of the other nodes it reaches:
+//
+//	score = (r / (n-1)) * (r / Σ d)
+//
+// Parameters:
+//   - g: The graph to compute the closeness centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the corrected closeness scores.
+//
+// Notes:
+//   - On connected graphs r = n-1 and the scores equal those of ClosenessCentrality. On disconnected graphs,
+//     nodes in small components no longer outscore nodes in large ones merely because their distances are short.
+//   - Nodes that reach no other node score 0.
+func (u *Unit) ClosenessCentralityWF(g *graph.Graph) map[graph.Identifier]float64 {
+	sums := u.distanceSums(g)
+	n := g.NodeCount()
+
+	centrality := make(map[graph.Identifier]float64, n)
+
+	for _, id := range g.NodeIDs() {
+		centrality[id] = 0
+
+		if reached := float64(sums.reached[id]); reached > 0 && sums.total[id] > 0 {
+			centrality[id] = reached / float64(n-1) * reached / float64(sums.total[id])
+		}
+	}
+
+	return centrality
+}
+
+// ComponentAwareCloseness computes the closeness centrality of each node for a Unit within its own connected component.
+// The closeness of a node in a component of s nodes is
+//
+//	score = (s - 1) / Σ d
+//
+// where the sum runs over the other nodes of the component, so every component is normalized by its own size.
+//
+// Parameters:
+//   - g: The graph to compute the closeness centrality for. It is expected to be undirected, see ConnectedComponents.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the closeness scores.
+//     Nodes in a component of their own score 0, so no score is NaN.
+//
+// Notes:
+//   - Unlike ClosenessCentralityWF, the score does not depend on the size of the component, so the center of a small
+//     component and the center of a large one are both scored as within a connected graph. On a connected graph the
+//     scores equal those of ClosenessCentrality.
+//   - In a directed graph a node that does not reach its whole component is scored as if the missing distances were 0.
+func (u *Unit) ComponentAwareCloseness(g *graph.Graph) map[graph.Identifier]float64 {
+	sums := u.distanceSums(g)
+	centrality := make(map[graph.Identifier]float64, g.NodeCount())
+
+	for _, component := range ConnectedComponents(g) {
+		for _, id := range component {
+			centrality[id] = 0
+
+			if total := sums.total[id]; len(component) > 1 && total > 0 {
+				centrality[id] = float64(len(component)-1) / float64(total)
+			}
+		}
+	}
+
+	return centrality
+}
+
+// ClosenessAfterRemoval computes the closeness centrality each node would have after removing a node from the graph,
+// reusing the cached shortest paths of a Unit instead of recomputing all of them. Neither the graph nor the cache is modified.
+//
+// Parameters:
+//   - g: The graph to compute the closeness centrality for.
+//   - removed: The identifier of the node to remove.
+//
+// Returns:
+//   - A map where the keys are the identifiers of the remaining nodes and the values are their closeness scores,
+//     as ClosenessCentrality would compute them on the graph without the removed node.
+//     If the node does not exist, the closeness of the unchanged graph.
+//
+// Notes:
+//   - Removing a node never shortens a path, so a cached path that avoids the removed node is still shortest.
+//     Only sources with at least one cached path through the removed node are affected; each of them gets one
+//     fresh single-source search on the remaining graph, and all other sources keep their cached distances.
+//   - The result is exact, provided the cache matches the graph; it is refreshed first if the graph changed.
+//   - The cost is one pass over the cached paths plus O(k·(n + m) log n) for k affected sources, against
+//     O(n·(n + m) log n) for a fresh computePaths. When the removed node is a hub, most sources are affected,
+//     and the gain shrinks to the cost of not rebuilding and sorting the cache.
+//   - A source counts as affected even if an equally short path avoids the removed node, since the cache keeps
+//     one path per pair.
+func (u *Unit) ClosenessAfterRemoval(g *graph.Graph, removed graph.Identifier) map[graph.Identifier]float64 {
+	if _, e := g.FindNode(removed); e != nil {
+		return u.ClosenessCentrality(g)
+	}
+
+	if !g.Updated() || !u.updated {
+		// Recompute shortest paths if the graph or unit has been updated.
+		u.computePaths(g)
+	}
+
+	affected := make(map[graph.Identifier]bool)
+	sums := newDistanceSums()
+
+	u.forEachPath(func(path graph.Path) {
+		nodes := path.Nodes()
+		source, target := nodes[0], nodes[len(nodes)-1]
+
+		if source == removed || target == removed || affected[source] {
+			return
+		}
+
+		for _, node := range nodes[1 : len(nodes)-1] {
+			if node == removed {
+				affected[source] = true
+				return
+			}
+		}
+
+		sums.add(path)
+	})
+
+	remaining := make([]graph.Identifier, 0, g.NodeCount()-1)
+	for _, id := range sortedIDs(g) {
+		if id != removed {
+			remaining = append(remaining, id)
+		}
+	}
+
+	subgraph, mapping := g.Subgraph(remaining)
+	original := make(map[graph.Identifier]graph.Identifier, len(mapping))
+	for id, mapped := range mapping {
+		original[mapped] = id
+	}
+
+	for _, source := range remaining {
+		if !affected[source] {
+			continue
+		}
+
+		// Discard the partial statistics of the source and search again without the removed node.
+		delete(sums.total, source)
+		delete(sums.reached, source)
+
+		for target, distance := range searchFrom(subgraph, mapping[source], 0, false).dist {
+			if target != mapping[source] {
+				sums.add(*graph.NewPath(distance, []graph.Identifier{source, original[target]}))
+			}
+		}
+	}
+
+	centrality := make(map[graph.Identifier]float64, len(remaining))
+
+	for _, id := range remaining {
+		centrality[id] = 0
+
+		if sums.reached[id] > 0 && sums.total[id] > 0 {
+			centrality[id] = float64(sums.reached[id]) / float64(sums.total[id])
+		}
+	}
+
+	return centrality
+}
+
+// ParallelUnit version of ClosenessCentrality.
+// Computes the closeness centrality using parallel computations.
+func (pu *ParallelUnit) ClosenessCentrality(g *graph.Graph) map[graph.Identifier]float64 {
+	return pu.DistanceMetrics(g).Closeness
+}
+
+// HarmonicCentrality computes the harmonic centrality of each node for a Unit.
+// The harmonic centrality of a node is the sum of the inverse distances to all other nodes, divided by n-1.
+//
+// Parameters:
+//   - g: The graph to compute the harmonic centrality for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the harmonic scores between 0 and 1.
+//
+// Notes:
+//   - Unreachable nodes contribute 0, so unlike closeness it is well defined on disconnected graphs.
+func (u *Unit) HarmonicCentrality(g *graph.Graph) map[graph.Identifier]float64 {
+	return u.DistanceMetrics(g).Harmonic
+}
+
+// ParallelUnit version of HarmonicCentrality.
+// Computes the harmonic centrality using parallel computations.
+func (pu *ParallelUnit) HarmonicCentrality(g *graph.Graph) map[graph.Identifier]float64 {
+	return pu.DistanceMetrics(g).Harmonic
+}
+
+// Eccentricity computes the eccentricity of each node for a Unit, i.e. its largest distance to a reachable node.
+//
+// Parameters:
+//   - g: The graph to compute the eccentricity for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the eccentricities.
+//
+// Notes:
+//   - Distances are the total weights of the stored shortest paths, so on weighted graphs this is the weighted
+//     eccentricity; on unweighted graphs it counts hops. See HopEccentricity to count hops on weighted graphs.
+//   - Unreachable nodes are ignored, and nodes that reach no other node have eccentricity 0.
+func (u *Unit) Eccentricity(g *graph.Graph) map[graph.Identifier]graph.Distance {
+	return u.DistanceMetrics(g).Eccentricity
+}
+
+// ParallelUnit version of Eccentricity.
+// Computes the eccentricity using parallel computations.
+func (pu *ParallelUnit) Eccentricity(g *graph.Graph) map[graph.Identifier]graph.Distance {
+	return pu.DistanceMetrics(g).Eccentricity
+}
+
+// HopEccentricity computes the eccentricity of each node for a Unit in hops, ignoring edge weights:
+// the largest number of edges on a fewest-hop route to a reachable node.
+//
+// Parameters:
+//   - g: The graph to compute the eccentricity for.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the hop eccentricities.
+//
+// Notes:
+//   - On unweighted graphs it equals Eccentricity. On weighted graphs the two often differ, since a route
+//     over many light edges can be shorter than one over a few heavy ones.
+//   - It runs one breadth-first search per node with HopDistances and does not use the stored paths.
+func (u *Unit) HopEccentricity(g *graph.Graph) map[graph.Identifier]graph.Distance {
+	eccentricity := make(map[graph.Identifier]graph.Distance, g.NodeCount())
+
+	for _, id := range g.NodeIDs() {
+		eccentricity[id] = 0
+
+		for _, hops := range u.HopDistances(g, id) {
+			if hops > 0 {
+				eccentricity[id] = max(eccentricity[id], graph.Distance(hops))
+			}
+		}
+	}
+
+	return eccentricity
+}
+
+// GraphCenter finds the center of the graph: the nodes with the smallest eccentricity.
+//
+// Parameters:
+//   - g: The graph to find the center of.
+//
+// Returns:
+//   - The identifiers of the central nodes in ascending order; empty if the graph has no nodes.
+//
+// Notes:
+//   - Eccentricities are those of Eccentricity, measured over the stored shortest paths, so they are total
+//     edge weights on weighted graphs and hop counts on unweighted ones. GraphCenterHops ignores the weights.
+//   - Unreachable nodes are ignored, so on disconnected graphs an isolated node, with eccentricity 0, is central.
+//     The result is meaningful for connected (or, if directed, strongly connected) graphs.
+func GraphCenter(g *graph.Graph) []graph.Identifier {
+	return eccentricityExtremes(g, NewUnit().Eccentricity(g), false)
+}
+
+// GraphPeriphery finds the periphery of the graph: the nodes with the largest eccentricity, i.e. the endpoints
+// of the diameter.
+//
+// Parameters:
+//   - g: The graph to find the periphery of.
+//
+// Returns:
+//   - The identifiers of the peripheral nodes in ascending order; empty if the graph has no nodes.
+//
+// Notes:
+//   - Eccentricities are measured as for GraphCenter. GraphPeripheryHops ignores the weights.
+func GraphPeriphery(g *graph.Graph) []graph.Identifier {
+	return eccentricityExtremes(g, NewUnit().Eccentricity(g), true)
+}
+
+// GraphCenterHops finds the center of the graph measured in hops: the nodes with the smallest HopEccentricity.
+// On weighted graphs it is the center of the unweighted topology, and may differ from GraphCenter.
+//
+// Parameters:
+//   - g: The graph to find the center of. Edge weights are ignored.
+//
+// Returns:
+//   - The identifiers of the central nodes in ascending order; empty if the graph has no nodes.
+func GraphCenterHops(g *graph.Graph) []graph.Identifier {
+	return eccentricityExtremes(g, NewUnit().HopEccentricity(g), false)
+}
+
+// GraphPeripheryHops finds the periphery of the graph measured in hops: the nodes with the largest HopEccentricity.
+//
+// Parameters:
+//   - g: The graph to find the periphery of. Edge weights are ignored.
+//
+// Returns:
+//   - The identifiers of the peripheral nodes in ascending order; empty if the graph has no nodes.
+func GraphPeripheryHops(g *graph.Graph) []graph.Identifier {
+	return eccentricityExtremes(g, NewUnit().HopEccentricity(g), true)
+}
+
+// eccentricityExtremes returns, in ascending order, the nodes whose eccentricity is the smallest,
+// or the largest if largest is set.
+func eccentricityExtremes(g *graph.Graph, eccentricity map[graph.Identifier]graph.Distance, largest bool) []graph.Identifier {
+	result := []graph.Identifier{}
+
+	for _, id := range sortedIDs(g) {
+		if len(result) == 0 {
+			result = append(result, id)
+			continue
+		}
+
+		best := eccentricity[result[0]]
+
+		switch {
+		case eccentricity[id] == best:
+			result = append(result, id)
+		case (eccentricity[id] > best) == largest:
+			result = []graph.Identifier{id}
+		}
+	}
+
+	return result
+}
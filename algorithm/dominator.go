@@ -0,0 +1,113 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// DominatorTree computes the immediate dominator of every node reachable from an entry node.
+// A node X dominates Y if every path from the entry to Y passes through X; the immediate dominator of Y
+// is its closest strict dominator, and linking each node to it forms the dominator tree.
+//
+// Parameters:
+//   - g: The graph to analyze, typically a directed control-flow graph.
+//   - entry: The identifier of the entry node.
+//
+// Returns:
+//   - A map from each node reachable from the entry to its immediate dominator. The entry maps to itself.
+//     Unreachable nodes are omitted, and the map is empty if the entry does not exist.
+//
+// Notes:
+//   - It uses the iterative algorithm of Cooper, Harvey, and Kennedy, which intersects the dominators of
+//     the predecessors in reverse postorder until a fixed point. It is simple and fast in practice,
+//     although its worst case is O(n²) compared with the near-linear Lengauer-Tarjan algorithm.
+func DominatorTree(g *graph.Graph, entry graph.Identifier) map[graph.Identifier]graph.Identifier {
+	idom := make(map[graph.Identifier]graph.Identifier)
+
+	if _, e := g.FindNode(entry); e != nil {
+		return idom
+	}
+
+	// Number the reachable nodes in postorder with an iterative depth-first search.
+	postorder := make(map[graph.Identifier]int)
+	order := []graph.Identifier{}
+	visited := map[graph.Identifier]bool{entry: true}
+
+	type frame struct {
+		node graph.Identifier
+		next int
+	}
+
+	stack := []frame{{node: entry}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		neighbors := g.Neighbors(top.node)
+
+		if top.next < len(neighbors) {
+			to := neighbors[top.next]
+			top.next++
+
+			if !visited[to] {
+				visited[to] = true
+				stack = append(stack, frame{node: to})
+			}
+
+			continue
+		}
+
+		postorder[top.node] = len(order)
+		order = append(order, top.node)
+		stack = stack[:len(stack)-1]
+	}
+
+	predecessors := make(map[graph.Identifier][]graph.Identifier, len(order))
+	for _, from := range order {
+		for _, to := range g.Neighbors(from) {
+			predecessors[to] = append(predecessors[to], from)
+		}
+	}
+
+	// intersect walks both nodes up the current tree until they meet at their common dominator.
+	intersect := func(a, b graph.Identifier) graph.Identifier {
+		for a != b {
+			for postorder[a] < postorder[b] {
+				a = idom[a]
+			}
+			for postorder[b] < postorder[a] {
+				b = idom[b]
+			}
+		}
+
+		return a
+	}
+
+	idom[entry] = entry
+
+	for changed := true; changed; {
+		changed = false
+
+		// Visit the nodes in reverse postorder, skipping the entry.
+		for i := len(order) - 2; i >= 0; i-- {
+			node := order[i]
+			candidate, found := graph.Identifier(0), false
+
+			for _, pred := range predecessors[node] {
+				if _, processed := idom[pred]; !processed {
+					continue
+				}
+
+				if !found {
+					candidate, found = pred, true
+				} else {
+					candidate = intersect(pred, candidate)
+				}
+			}
+
+			if current, ok := idom[node]; found && (!ok || current != candidate) {
+				idom[node] = candidate
+				changed = true
+			}
+		}
+	}
+
+	return idom
+}
@@ -0,0 +1,104 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// EdgeBetweenness computes the betweenness centrality of each edge in the graph for a Unit.
+// Edge betweenness counts the shortest paths that traverse each edge. Like node betweenness, it uses
+// Brandes' algorithm, so a pair with several shortest paths splits its credit evenly among them.
+//
+// Parameters:
+//   - g: The graph to compute the edge betweenness for.
+//
+// Returns:
+//   - A map keyed by edges {from, to} whose values are the normalized edge betweenness scores.
+//     For undirected graphs each edge appears once, with the smaller identifier first.
+//
+// Notes:
+//   - Scores are normalized by the number of ordered node pairs n(n-1), the edge analogue
+//     of the (n-1)(n-2) used for node betweenness.
+//   - Parallel edges share one key, which sums their scores.
+//   - One search runs per source node, so the result does not depend on the cached shortest paths.
+func (u *Unit) EdgeBetweenness(g *graph.Graph) map[[2]graph.Identifier]float64 {
+	centrality := initEdgeBetweenness(g)
+
+	for _, source := range sortedIDs(g) {
+		brandesSource(g, source).accumulateEdges(g, centrality)
+	}
+
+	normalizeEdgeBetweenness(g, centrality)
+
+	return centrality
+}
+
+// EdgeBetweenness computes the betweenness centrality of each edge in the graph for a ParallelUnit.
+// The computation is performed in parallel for better performance on larger graphs.
+//
+// Parameters:
+//   - g: The graph to compute the edge betweenness for.
+//
+// Returns:
+//   - A map keyed by edges {from, to} whose values are the normalized edge betweenness scores.
+//     For undirected graphs each edge appears once, with the smaller identifier first.
+func (pu *ParallelUnit) EdgeBetweenness(g *graph.Graph) map[[2]graph.Identifier]float64 {
+	ids := sortedIDs(g)
+	centrality := initEdgeBetweenness(g)
+
+	// Process one batch of sources per round, so only a batch of dependency maps is held at once.
+	batch := pu.workers()
+	deltas := make([]map[[2]graph.Identifier]float64, batch)
+
+	for lo := 0; lo < len(ids); lo += batch {
+		hi := min(lo+batch, len(ids))
+
+		pu.parallelFor(hi-lo, func(i int) {
+			deltas[i] = make(map[[2]graph.Identifier]float64)
+			brandesSource(g, ids[lo+i]).accumulateEdges(g, deltas[i])
+		})
+
+		// Add the dependencies in source order, so the sums are bit-identical to those of a Unit.
+		for i := 0; i < hi-lo; i++ {
+			for edge, delta := range deltas[i] {
+				centrality[edge] += delta
+			}
+		}
+	}
+
+	normalizeEdgeBetweenness(g, centrality)
+
+	return centrality
+}
+
+// initEdgeBetweenness creates a map with a zero score for every edge of the graph.
+func initEdgeBetweenness(g *graph.Graph) map[[2]graph.Identifier]float64 {
+	centrality := make(map[[2]graph.Identifier]float64, g.EdgeCount())
+
+	for _, from := range g.NodeIDs() {
+		for _, to := range g.Neighbors(from) {
+			centrality[edgeKey(g, from, to)] = 0
+		}
+	}
+
+	return centrality
+}
+
+// normalizeEdgeBetweenness divides every score by the number of ordered node pairs.
+func normalizeEdgeBetweenness(g *graph.Graph, centrality map[[2]graph.Identifier]float64) {
+	n := g.NodeCount()
+
+	if n > 1 {
+		for edge := range centrality {
+			centrality[edge] /= float64(n * (n - 1))
+		}
+	}
+}
+
+// edgeKey returns the map key of the edge (from, to), ordering the endpoints for undirected graphs.
+func edgeKey(g *graph.Graph, from, to graph.Identifier) [2]graph.Identifier {
+	if g.Directed() {
+		return [2]graph.Identifier{from, to}
+	}
+
+	return orderedPair(from, to)
+}
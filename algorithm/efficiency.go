@@ -1,7 +1,7 @@
 package algorithm
 
 import (
-	"sync"
+	"sort"
 
 	"github.com/elecbug/go-graphtric/graph"
 )
@@ -24,9 +24,9 @@ func (u *Unit) GlobalEfficiency(g *graph.Graph) float64 {
 	var pairCount int
 
 	// Iterate through all shortest paths to compute the efficiency
-	for _, path := range u.shortestPaths {
-		if path.Distance() != graph.INF && path.Distance() > 0 {
-			totalEfficiency += 1.0 / float64(path.Distance())
+	for _, distance := range u.sortedDistances() {
+		if distance != graph.INF && distance > 0 {
+			totalEfficiency += 1.0 / float64(distance)
 			pairCount++
 		}
 	}
@@ -56,9 +56,9 @@ func (pu *ParallelUnit) GlobalEfficiency(g *graph.Graph) float64 {
 	var pairCount int
 
 	// Iterate through all shortest paths to compute the efficiency
-	for _, path := range pu.shortestPaths {
-		if path.Distance() != graph.INF && path.Distance() > 0 {
-			totalEfficiency += 1.0 / float64(path.Distance())
+	for _, distance := range pu.sortedDistances() {
+		if distance != graph.INF && distance > 0 {
+			totalEfficiency += 1.0 / float64(distance)
 			pairCount++
 		}
 	}
@@ -85,55 +85,11 @@ func (u *Unit) LocalEfficiency(g *graph.Graph) map[graph.Identifier]float64 {
 	}
 
 	localEfficiency := make(map[graph.Identifier]float64)
-
-	// Group paths by their starting node
-	pathsBySource := make(map[graph.Identifier][]graph.Path)
-	for _, path := range u.shortestPaths {
-		if len(path.Nodes()) > 0 {
-			source := path.Nodes()[0]
-			pathsBySource[source] = append(pathsBySource[source], path)
-		}
-	}
+	sources, hops, distance := u.efficiencyIndex()
 
 	// Compute local efficiency for each node
-	for node, paths := range pathsBySource {
-		neighbors := make(map[graph.Identifier]bool)
-
-		// Identify neighbors of the current node
-		for _, path := range paths {
-			if len(path.Nodes()) > 1 {
-				neighbors[path.Nodes()[1]] = true
-			}
-		}
-
-		neighborList := make([]graph.Identifier, 0, len(neighbors))
-		for neighbor := range neighbors {
-			neighborList = append(neighborList, neighbor)
-		}
-
-		k := len(neighborList)
-		if k < 2 {
-			// Local efficiency is undefined for nodes with fewer than 2 neighbors.
-			localEfficiency[node] = 0.0
-			continue
-		}
-
-		// Calculate efficiency among neighbors
-		totalEfficiency := 0.0
-		for i := 0; i < k; i++ {
-			for j := i + 1; j < k; j++ {
-				for _, path := range u.shortestPaths {
-					if len(path.Nodes()) > 1 && path.Nodes()[0] == neighborList[i] && path.Nodes()[len(path.Nodes())-1] == neighborList[j] {
-						if path.Distance() != graph.INF && path.Distance() > 0 {
-							totalEfficiency += 1.0 / float64(path.Distance())
-						}
-					}
-				}
-			}
-		}
-
-		// Normalize by the number of possible connections
-		localEfficiency[node] = totalEfficiency / float64(k*(k-1))
+	for _, node := range sources {
+		localEfficiency[node] = localEfficiencyOf(hops[node], distance)
 	}
 
 	return localEfficiency
@@ -154,78 +110,97 @@ func (pu *ParallelUnit) LocalEfficiency(g *graph.Graph) map[graph.Identifier]flo
 	}
 
 	localEfficiency := make(map[graph.Identifier]float64)
-	efficiencyChan := make(chan struct {
-		node  graph.Identifier
-		value float64
-	}, len(pu.shortestPaths))
-	var wg sync.WaitGroup
-
-	// Group paths by their starting node
-	pathsBySource := make(map[graph.Identifier][]graph.Path)
-	for _, path := range pu.shortestPaths {
-		if len(path.Nodes()) > 0 {
-			source := path.Nodes()[0]
-			pathsBySource[source] = append(pathsBySource[source], path)
-		}
-	}
+	sources, hops, distance := pu.efficiencyIndex()
+	values := make([]float64, len(sources))
 
 	// Compute local efficiency for each node in parallel
-	for node, paths := range pathsBySource {
-		wg.Add(1)
-		go func(node graph.Identifier, paths []graph.Path) {
-			defer wg.Done()
-
-			neighbors := make(map[graph.Identifier]bool)
-			for _, path := range paths {
-				if len(path.Nodes()) > 1 {
-					neighbors[path.Nodes()[1]] = true
-				}
-			}
+	pu.parallelFor(len(sources), func(s int) {
+		values[s] = localEfficiencyOf(hops[sources[s]], distance)
+	})
 
-			neighborList := make([]graph.Identifier, 0, len(neighbors))
-			for neighbor := range neighbors {
-				neighborList = append(neighborList, neighbor)
-			}
+	// Collect results
+	for s, source := range sources {
+		localEfficiency[source] = values[s]
+	}
 
-			k := len(neighborList)
-			if k < 2 {
-				efficiencyChan <- struct {
-					node  graph.Identifier
-					value float64
-				}{node: node, value: 0.0}
-				return
-			}
+	return localEfficiency
+}
 
-			totalEfficiency := 0.0
-			for i := 0; i < k; i++ {
-				for j := i + 1; j < k; j++ {
-					for _, path := range pu.shortestPaths {
-						if len(path.Nodes()) > 1 && path.Nodes()[0] == neighborList[i] && path.Nodes()[len(path.Nodes())-1] == neighborList[j] {
-							if path.Distance() != graph.INF && path.Distance() > 0 {
-								totalEfficiency += 1.0 / float64(path.Distance())
-							}
-						}
-					}
-				}
-			}
+// efficiencyIndex collects the inputs of the local efficiency in one pass over the cached paths.
+//
+// Returns:
+//   - The sources of the cached paths in ascending order.
+//   - The neighbors of each source, i.e. the second nodes of its paths, in ascending order.
+//   - A lookup of the distance between two nodes, reporting false if no path is cached.
+func (u *Unit) efficiencyIndex() ([]graph.Identifier, map[graph.Identifier][]graph.Identifier, func(a, b graph.Identifier) (graph.Distance, bool)) {
+	neighbors := make(map[graph.Identifier]map[graph.Identifier]bool)
+	var pairs map[[2]graph.Identifier]graph.Distance
+
+	if u.trees == nil {
+		pairs = make(map[[2]graph.Identifier]graph.Distance, len(u.shortestPaths))
+	}
+
+	u.forEachPath(func(path graph.Path) {
+		nodes := path.Nodes()
+
+		if neighbors[nodes[0]] == nil {
+			neighbors[nodes[0]] = make(map[graph.Identifier]bool)
+		}
+		if len(nodes) > 1 {
+			neighbors[nodes[0]][nodes[1]] = true
+		}
+		if pairs != nil {
+			pairs[[2]graph.Identifier{nodes[0], nodes[len(nodes)-1]}] = path.Distance()
+		}
+	})
+
+	sources := make([]graph.Identifier, 0, len(neighbors))
+	hops := make(map[graph.Identifier][]graph.Identifier, len(neighbors))
 
-			efficiencyChan <- struct {
-				node  graph.Identifier
-				value float64
-			}{node: node, value: totalEfficiency / float64(k*(k-1))}
-		}(node, paths)
+	for source, set := range neighbors {
+		sources = append(sources, source)
+
+		// Sort the neighbors so the efficiency is summed in a deterministic order.
+		for neighbor := range set {
+			hops[source] = append(hops[source], neighbor)
+		}
+		sort.Slice(hops[source], func(i, j int) bool { return hops[source][i] < hops[source][j] })
 	}
 
-	// Close the channel after all goroutines complete
-	go func() {
-		wg.Wait()
-		close(efficiencyChan)
-	}()
+	sort.Slice(sources, func(i, j int) bool { return sources[i] < sources[j] })
 
-	// Collect results
-	for res := range efficiencyChan {
-		localEfficiency[res.node] = res.value
+	distance := func(a, b graph.Identifier) (graph.Distance, bool) {
+		if pairs != nil {
+			d, ok := pairs[[2]graph.Identifier{a, b}]
+			return d, ok
+		}
+
+		d := u.trees.dist[u.trees.index[a]][u.trees.index[b]]
+		return d, d != graph.INF && a != b
 	}
 
-	return localEfficiency
+	return sources, hops, distance
+}
+
+// localEfficiencyOf computes the efficiency among the neighbors of a node.
+// Each unordered pair of neighbors contributes the inverse distance from the smaller to the larger identifier.
+func localEfficiencyOf(neighbors []graph.Identifier, distance func(a, b graph.Identifier) (graph.Distance, bool)) float64 {
+	k := len(neighbors)
+	if k < 2 {
+		// Local efficiency is undefined for nodes with fewer than 2 neighbors.
+		return 0.0
+	}
+
+	// Calculate efficiency among neighbors
+	totalEfficiency := 0.0
+	for i := 0; i < k; i++ {
+		for j := i + 1; j < k; j++ {
+			if d, ok := distance(neighbors[i], neighbors[j]); ok && d > 0 {
+				totalEfficiency += 1.0 / float64(d)
+			}
+		}
+	}
+
+	// Normalize by the number of possible connections
+	return totalEfficiency / float64(k*(k-1))
 }
@@ -0,0 +1,147 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// EulerianPath finds a trail that traverses every edge of the graph exactly once, using Hierholzer's algorithm.
+//
+// Parameters:
+//   - g: The graph to traverse. Edge weights are ignored.
+//
+// Returns:
+//   - The nodes of the trail in order, one more than the number of edges. For an Eulerian circuit the first and
+//     last nodes are equal; for an open path they are the two endpoints. Empty if the graph has no edges.
+//   - true if an Eulerian trail exists, false otherwise (the trail is then nil).
+//
+// Notes:
+//   - Undirected graphs have a circuit if every node has even degree, and an open path if exactly two nodes
+//     have odd degree; the path then starts at the smaller of them.
+//   - Directed graphs have a circuit if every node has equal in- and out-degree, and an open path if one node has
+//     one more outgoing edge (the start) and one node one more incoming edge (the end), all others being balanced.
+//   - Circuits start at the smallest identifier with an edge. In both cases all edges must be connected,
+//     and ties are broken toward smaller identifiers, so the result is deterministic.
+//   - Parallel edges of a multigraph are separate edges, each traversed once; a self-loop is one edge
+//     that adds 2 to the degree of its node.
+func EulerianPath(g *graph.Graph) ([]graph.Identifier, bool) {
+	directed := g.Directed()
+	adjacency := make(map[graph.Identifier][]graph.Identifier, g.NodeCount())
+	balance := make(map[graph.Identifier]int, g.NodeCount()) // out-degree minus in-degree, or degree if undirected
+	edges := 0
+
+	// remaining counts the unused edges per node pair, so that parallel edges are each traversed once.
+	remaining := make(map[[2]graph.Identifier]int)
+
+	for _, id := range sortedIDs(g) {
+		neighbors := g.Neighbors(id)
+		sort.Slice(neighbors, func(i, j int) bool { return neighbors[i] < neighbors[j] })
+		adjacency[id] = neighbors
+
+		for _, to := range neighbors {
+			if directed {
+				balance[id]++
+				balance[to]--
+				edges++
+				remaining[[2]graph.Identifier{id, to}]++
+			} else {
+				balance[id]++
+				if id < to {
+					edges++
+					remaining[orderedPair(id, to)]++
+				}
+
+				// A self-loop is listed once but has two ends at its node.
+				if id == to {
+					balance[id]++
+					edges++
+					remaining[orderedPair(id, to)]++
+				}
+			}
+		}
+	}
+
+	if edges == 0 {
+		return []graph.Identifier{}, true
+	}
+
+	start, found := graph.Identifier(0), false
+	odd := 0
+
+	for _, id := range sortedIDs(g) {
+		if !found && len(adjacency[id]) > 0 {
+			start, found = id, true
+		}
+	}
+
+	for _, id := range sortedIDs(g) {
+		if directed {
+			switch balance[id] {
+			case 0:
+			case 1:
+				if odd++; odd > 1 {
+					return nil, false
+				}
+				start = id
+			case -1:
+			default:
+				return nil, false
+			}
+		} else if balance[id]%2 == 1 {
+			if odd == 0 {
+				start = id
+			}
+			odd++
+		}
+	}
+
+	if !directed && odd != 0 && odd != 2 {
+		return nil, false
+	}
+
+	// Hierholzer: extend the current trail until stuck, then back up and splice in sub-circuits.
+	next := make(map[graph.Identifier]int, len(adjacency))
+
+	stack := []graph.Identifier{start}
+	trail := make([]graph.Identifier, 0, edges+1)
+
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		moved := false
+
+		for next[node] < len(adjacency[node]) {
+			to := adjacency[node][next[node]]
+			next[node]++
+
+			key := [2]graph.Identifier{node, to}
+			if !directed {
+				key = orderedPair(node, to)
+			}
+
+			if remaining[key] > 0 {
+				remaining[key]--
+				stack = append(stack, to)
+				moved = true
+
+				break
+			}
+		}
+
+		if !moved {
+			trail = append(trail, node)
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	// Edges in another component were never reached.
+	if len(trail) != edges+1 {
+		return nil, false
+	}
+
+	for i, j := 0, len(trail)-1; i < j; i, j = i+1, j-1 {
+		trail[i], trail[j] = trail[j], trail[i]
+	}
+
+	return trail, true
+}
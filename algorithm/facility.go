@@ -0,0 +1,64 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// KCenter selects k center nodes so that every node is close to its nearest center, using the greedy
+// 2-approximation of Gonzalez: starting from one node, it repeatedly adds the node farthest from all chosen centers.
+// The covering radius, the largest distance from a node to its nearest center, is at most twice the optimum.
+//
+// Parameters:
+//   - g: The graph to place the centers in. Distances are shortest path lengths, measured from the centers outward.
+//   - k: The number of centers to select.
+//
+// Returns:
+//   - The chosen centers in the order they were selected. The first one is the smallest identifier.
+//   - The covering radius, or INF64 if some node is unreachable from every center.
+//     If k is at least the number of nodes, every node is a center and the radius is 0;
+//     if k is less than 1 or the graph is empty, no center is chosen and the radius is 0.
+//
+// Notes:
+//   - Each selected center runs one single-source search, after which the distance of every node to its nearest
+//     center is updated, so the cost is O(k·(n + m) log n) instead of an all-pairs computation.
+//   - Unreachable nodes count as infinitely far, so on a disconnected graph every component gets a center
+//     before any component gets a second one, as long as k allows.
+//   - Ties for the farthest node are broken toward smaller identifiers, so the result is deterministic.
+func KCenter(g *graph.Graph, k int) ([]graph.Identifier, int64) {
+	ids := sortedIDs(g)
+	centers := []graph.Identifier{}
+
+	if k < 1 || len(ids) == 0 {
+		return centers, 0
+	}
+
+	// nearest holds the distance of each node to its nearest chosen center.
+	nearest := make(map[graph.Identifier]graph.Distance, len(ids))
+	for _, id := range ids {
+		nearest[id] = graph.INF
+	}
+
+	next := ids[0]
+
+	for len(centers) < k && len(centers) < len(ids) {
+		centers = append(centers, next)
+
+		for id, d := range searchFrom(g, next, next, false).dist {
+			nearest[id] = min(nearest[id], d)
+		}
+
+		// The farthest node from all centers is the next center, and its distance the covering radius.
+		next = ids[0]
+		for _, id := range ids {
+			if nearest[id] > nearest[next] {
+				next = id
+			}
+		}
+	}
+
+	if nearest[next] == graph.INF {
+		return centers, INF64
+	}
+
+	return centers, int64(nearest[next])
+}
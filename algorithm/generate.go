@@ -0,0 +1,303 @@
+package algorithm
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	err "github.com/elecbug/go-graphtric/err" // Custom error package
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// configurationAttempts is the number of stub matchings GenerateConfigurationModel tries before erasing conflicts.
+const configurationAttempts = 100
+
+// shuffleAttempts is the number of candidate swaps DegreePreservingShuffle draws per requested swap before giving up.
+const shuffleAttempts = 100
+
+// GenerateRandomGeometric builds a random geometric graph, a common model of sensor and wireless networks.
+// n points are placed uniformly at random in the unit square, and every pair of points whose Euclidean
+// distance is at most radius is joined by an edge.
+//
+// Parameters:
+//   - n: The number of nodes. Node i is named after its identifier.
+//   - radius: The connection radius.
+//   - seed: The seed of the point placement, making the result reproducible.
+//
+// Returns:
+//   - An undirected unweighted graph with n nodes.
+//   - A map from each node identifier to its {x, y} coordinates, usable e.g. as an A* heuristic.
+//
+// Notes:
+//   - Away from the borders a node has about (n-1)πr² neighbors, so the expected number of edges grows with
+//     the square of the radius, roughly n(n-1)/2 * πr² for small radii. Nodes near the borders have fewer neighbors,
+//     so the actual count is somewhat lower, and every pair is connected once radius reaches √2.
+//   - The graph is connected with high probability once πr² exceeds about ln(n)/n.
+//   - All pairs are compared, which costs O(n²).
+func GenerateRandomGeometric(n int, radius float64, seed int64) (*graph.Graph, map[graph.Identifier][2]float64) {
+	g := graph.NewGraph(graph.UndirectedUnweighted, n)
+	r := rand.New(rand.NewSource(seed))
+	positions := make(map[graph.Identifier][2]float64, n)
+
+	for i := 0; i < n; i++ {
+		g.AddNode(fmt.Sprint(i))
+		positions[graph.Identifier(i)] = [2]float64{r.Float64(), r.Float64()}
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			a, b := positions[graph.Identifier(i)], positions[graph.Identifier(j)]
+
+			if math.Hypot(a[0]-b[0], a[1]-b[1]) <= radius {
+				g.AddEdge(graph.Identifier(i), graph.Identifier(j))
+			}
+		}
+	}
+
+	return g, positions
+}
+
+// GenerateSBM builds a random graph from a stochastic block model, a standard benchmark for community detection.
+// Nodes are assigned to consecutive blocks of the given sizes, and each pair of nodes is joined independently
+// with the probability given for the pair of their blocks.
+//
+// Parameters:
+//   - blockSizes: The number of nodes in each block. Block b holds the identifiers following those of blocks 0..b-1.
+//   - probMatrix: The symmetric matrix of connection probabilities, where entry [a][b] applies to pairs
+//     with one node in block a and the other in block b.
+//   - seed: The seed of the edge sampling, making the result reproducible.
+//
+// Returns:
+//   - An undirected unweighted graph with one node per block slot.
+//   - The ground-truth block of each node, for comparing the output of community detection.
+//   - An error if a block size is negative, or probMatrix is not a symmetric len(blockSizes) x len(blockSizes)
+//     matrix of probabilities in [0, 1].
+//
+// Notes:
+//   - High diagonal and low off-diagonal probabilities give assortative communities; the reverse gives a
+//     near-multipartite structure. All pairs are sampled, which costs O(n²).
+func GenerateSBM(blockSizes []int, probMatrix [][]float64, seed int64) (*graph.Graph, map[graph.Identifier]int, error) {
+	k := len(blockSizes)
+
+	if len(probMatrix) != k {
+		return nil, nil, err.InvalidArgument("probMatrix", fmt.Sprintf("%d rows for %d blocks", len(probMatrix), k))
+	}
+
+	n := 0
+	for a, size := range blockSizes {
+		if size < 0 {
+			return nil, nil, err.InvalidArgument("blockSizes", fmt.Sprintf("block %d has size %d", a, size))
+		}
+
+		if len(probMatrix[a]) != k {
+			return nil, nil, err.InvalidArgument("probMatrix", fmt.Sprintf("row %d has %d columns for %d blocks", a, len(probMatrix[a]), k))
+		}
+
+		n += size
+	}
+
+	for a := 0; a < k; a++ {
+		for b := 0; b < k; b++ {
+			p := probMatrix[a][b]
+
+			if p < 0 || p > 1 || p != probMatrix[b][a] {
+				return nil, nil, err.InvalidArgument("probMatrix", fmt.Sprintf("entry [%d][%d] = %v", a, b, p))
+			}
+		}
+	}
+
+	g := graph.NewGraph(graph.UndirectedUnweighted, n)
+	r := rand.New(rand.NewSource(seed))
+	blocks := make(map[graph.Identifier]int, n)
+
+	for b, size := range blockSizes {
+		for i := 0; i < size; i++ {
+			node, _ := g.AddNode(fmt.Sprint(len(blocks)))
+			blocks[node.ID()] = b
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			a, b := graph.Identifier(i), graph.Identifier(j)
+
+			if r.Float64() < probMatrix[blocks[a]][blocks[b]] {
+				g.AddEdge(a, b)
+			}
+		}
+	}
+
+	return g, blocks, nil
+}
+
+// GenerateConfigurationModel builds a random graph with the given degree sequence by stub matching,
+// the standard null model behind modularity. Each node receives as many stubs (half-edges) as its degree,
+// and the shuffled stubs are paired up into edges.
+//
+// Parameters:
+//   - degreeSequence: The degree of each node; node i receives degree degreeSequence[i].
+//   - seed: The seed of the stub shuffling, making the result reproducible.
+//
+// Returns:
+//   - An undirected unweighted graph with one node per entry of the sequence.
+//   - An error if the sequence is not graphical (see graph.IsGraphical).
+//
+// Notes:
+//   - The result is a simple graph, so self-loops and multi-edges are removed, never retained, even though
+//     graphs of this package can hold them, see graph.AllowSelfLoops and graph.AllowMultiEdges.
+//     Up to 100 matchings are drawn and the first without conflicts is used; if every matching has conflicts,
+//     the conflicting pairs of the last one are dropped (the erased configuration model), and the affected
+//     nodes end up with a lower degree than requested. This is rare for sparse sequences but
+//     likely for sequences with hubs whose degree approaches n.
+func GenerateConfigurationModel(degreeSequence []int, seed int64) (*graph.Graph, error) {
+	if !graph.IsGraphical(degreeSequence) {
+		return nil, err.InvalidArgument("degreeSequence", fmt.Sprintf("%v is not graphical", degreeSequence))
+	}
+
+	n := len(degreeSequence)
+	r := rand.New(rand.NewSource(seed))
+
+	stubs := []graph.Identifier{}
+	for i, degree := range degreeSequence {
+		for d := 0; d < degree; d++ {
+			stubs = append(stubs, graph.Identifier(i))
+		}
+	}
+
+	var pairs [][2]graph.Identifier
+
+	for attempt := 0; attempt < configurationAttempts; attempt++ {
+		r.Shuffle(len(stubs), func(i, j int) { stubs[i], stubs[j] = stubs[j], stubs[i] })
+
+		pairs = pairs[:0]
+		seen := make(map[[2]graph.Identifier]bool, len(stubs)/2)
+		simple := true
+
+		for i := 0; i+1 < len(stubs); i += 2 {
+			pair := orderedPair(stubs[i], stubs[i+1])
+
+			if pair[0] == pair[1] || seen[pair] {
+				simple = false
+				continue
+			}
+
+			seen[pair] = true
+			pairs = append(pairs, pair)
+		}
+
+		if simple {
+			break
+		}
+	}
+
+	g := graph.NewGraph(graph.UndirectedUnweighted, n)
+	for i := 0; i < n; i++ {
+		g.AddNode(fmt.Sprint(i))
+	}
+
+	for _, pair := range pairs {
+		g.AddEdge(pair[0], pair[1])
+	}
+
+	return g, nil
+}
+
+// DegreePreservingShuffle randomizes a copy of the graph while keeping the degree of every node, the standard
+// null model for testing whether clustering, assortativity, or motif counts are significant.
+// Each double-edge swap picks two edges a-b and c-d and rewires them into a-d and c-b.
+//
+// Parameters:
+//   - g: The graph to randomize. It is not modified.
+//   - swaps: The number of successful swaps to perform; about 10 per edge is usually enough to mix the graph.
+//   - seed: The seed of the swap selection, making the result reproducible.
+//
+// Returns:
+//   - A randomized graph of the same type, with the same identifiers, node names, and degree sequence.
+//
+// Notes:
+//   - Swaps that would create a self-loop or a parallel edge are rejected and redrawn, so a simple graph stays simple.
+//     At most 100 candidates are drawn per requested swap, so graphs that cannot be rewired, such as complete
+//     graphs, are returned after fewer swaps.
+//   - In directed graphs the swap a→b, c→d into a→d, c→b keeps every in- and out-degree.
+//     Undirected swaps also consider a-c and b-d, choosing the orientation of the second edge at random.
+//   - Weights and labels move with the source end of their edge, so weighted degrees are not preserved.
+//     Existing self-loops are copied unchanged and never swapped.
+func DegreePreservingShuffle(g *graph.Graph, swaps int, seed int64) *graph.Graph {
+	type shuffleEdge struct {
+		from, to graph.Identifier
+		weight   graph.Distance
+		label    string
+	}
+
+	directed := g.Directed()
+
+	// key identifies the node pair of an edge, ignoring the direction of undirected edges.
+	key := func(from, to graph.Identifier) [2]graph.Identifier {
+		if directed {
+			return [2]graph.Identifier{from, to}
+		}
+
+		return orderedPair(from, to)
+	}
+
+	edges, loops := []shuffleEdge{}, []shuffleEdge{}
+	count := make(map[[2]graph.Identifier]int)
+
+	for _, id := range sortedIDs(g) {
+		for _, e := range g.NeighborEdges(id) {
+			if !directed && e.To() < id {
+				continue // Undirected edges are stored at both ends.
+			}
+
+			edge := shuffleEdge{from: id, to: e.To(), weight: e.Distance(), label: e.Label()}
+
+			if edge.from == edge.to {
+				loops = append(loops, edge)
+			} else {
+				edges = append(edges, edge)
+				count[key(edge.from, edge.to)]++
+			}
+		}
+	}
+
+	r := rand.New(rand.NewSource(seed))
+
+	for done, attempts := 0, 0; done < swaps && attempts < swaps*shuffleAttempts && len(edges) >= 2; attempts++ {
+		i, j := r.Intn(len(edges)), r.Intn(len(edges))
+		if i == j {
+			continue
+		}
+
+		a, b := edges[i].from, edges[i].to
+		c, d := edges[j].from, edges[j].to
+
+		if !directed && r.Intn(2) == 0 {
+			c, d = d, c
+		}
+
+		first, second := key(a, d), key(c, b)
+		if a == d || c == b || first == second || count[first] > 0 || count[second] > 0 {
+			continue
+		}
+
+		count[key(a, b)]--
+		count[key(c, d)]--
+		count[first]++
+		count[second]++
+
+		edges[i].from, edges[i].to = a, d
+		edges[j].from, edges[j].to = c, b
+		done++
+	}
+
+	result := emptyCopy(g, g.Type())
+	result.AllowSelfLoops(g.SelfLoopsAllowed() || len(loops) > 0)
+	result.AllowMultiEdges(g.MultiEdgesAllowed())
+
+	for _, edge := range append(edges, loops...) {
+		result.AddWeightEdge(edge.from, edge.to, edge.weight)
+		result.SetEdgeLabel(edge.from, edge.to, edge.label)
+	}
+
+	return result
+}
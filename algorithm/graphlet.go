@@ -0,0 +1,227 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// CountGraphlets counts the connected induced subgraphs (graphlets) with three and four nodes for a Unit.
+// Every graphlet is enumerated exactly once with the ESU algorithm and classified by its shape.
+//
+// Parameters:
+//   - g: The graph to count the graphlets of.
+//
+// Returns:
+//   - A map from motif name to the number of occurrences; motifs that do not occur are omitted.
+//
+// Notes:
+//   - 3-node motifs of undirected graphs: "path3" and "triangle".
+//   - 3-node motifs of directed graphs without reciprocal edges: "chain3" (a→b→c), "fan-out" (a→b, a→c),
+//     "fan-in" (b→a, c→a), "feed-forward" (a→b→c, a→c), and "cycle3" (a→b→c→a).
+//     Any 3-node graphlet with a reciprocal pair of edges is counted as "mutual3".
+//   - 4-node motifs are classified on the underlying undirected graph for both graph types:
+//     "path4", "star4", "cycle4", "tailed-triangle", "diamond", and "clique4".
+func (u *Unit) CountGraphlets(g *graph.Graph) map[string]int {
+	counter := newGraphletCounter(g)
+	result := make(map[string]int)
+
+	for _, root := range counter.ids {
+		for motif, count := range counter.countFrom(root) {
+			result[motif] += count
+		}
+	}
+
+	return result
+}
+
+// CountGraphlets counts the connected induced subgraphs (graphlets) with three and four nodes for a ParallelUnit.
+// The enumeration is split by root node, the roots being processed on the worker pool.
+//
+// Parameters:
+//   - g: The graph to count the graphlets of.
+//
+// Returns:
+//   - A map from motif name to the number of occurrences, as described for Unit.CountGraphlets.
+func (pu *ParallelUnit) CountGraphlets(g *graph.Graph) map[string]int {
+	counter := newGraphletCounter(g)
+	result := make(map[string]int)
+
+	partials := make([]map[string]int, len(counter.ids))
+
+	// Enumerate the graphlets of each root node on the worker pool.
+	pu.parallelFor(len(counter.ids), func(i int) {
+		partials[i] = counter.countFrom(counter.ids[i])
+	})
+
+	// Aggregate the partial counts.
+	for _, counts := range partials {
+		for motif, count := range counts {
+			result[motif] += count
+		}
+	}
+
+	return result
+}
+
+// graphletCounter holds the read-only adjacency used to enumerate graphlets.
+type graphletCounter struct {
+	ids       []graph.Identifier                             // Node identifiers in ascending order.
+	neighbors map[graph.Identifier]map[graph.Identifier]bool // Undirected neighbor sets.
+	arcs      map[graph.Identifier]map[graph.Identifier]bool // Directed edges, nil for undirected graphs.
+}
+
+// newGraphletCounter prepares the adjacency of g for graphlet enumeration.
+func newGraphletCounter(g *graph.Graph) *graphletCounter {
+	counter := &graphletCounter{ids: sortedIDs(g), neighbors: undirectedNeighbors(g)}
+
+	if g.Directed() {
+		counter.arcs = make(map[graph.Identifier]map[graph.Identifier]bool, len(counter.ids))
+
+		for _, id := range counter.ids {
+			counter.arcs[id] = make(map[graph.Identifier]bool)
+
+			for _, to := range g.Neighbors(id) {
+				counter.arcs[id][to] = true
+			}
+		}
+	}
+
+	return counter
+}
+
+// countFrom enumerates the graphlets whose smallest node is root with the ESU algorithm.
+// A node joins the extension set only if it is larger than root and exclusively adjacent to the newest node,
+// which guarantees that each connected subgraph is produced once.
+func (c *graphletCounter) countFrom(root graph.Identifier) map[string]int {
+	counts := make(map[string]int)
+
+	var extend func(sub []graph.Identifier, ext []graph.Identifier)
+	extend = func(sub []graph.Identifier, ext []graph.Identifier) {
+		if len(sub) >= 3 {
+			counts[c.classify(sub)]++
+		}
+
+		if len(sub) == 4 {
+			return
+		}
+
+		for len(ext) > 0 {
+			w := ext[len(ext)-1]
+			ext = ext[:len(ext)-1]
+
+			next := append([]graph.Identifier{}, ext...)
+
+			for candidate := range c.neighbors[w] {
+				if candidate > root && !c.inNeighborhood(sub, candidate) {
+					next = append(next, candidate)
+				}
+			}
+
+			extend(append(append([]graph.Identifier{}, sub...), w), next)
+		}
+	}
+
+	ext := []graph.Identifier{}
+	for candidate := range c.neighbors[root] {
+		if candidate > root {
+			ext = append(ext, candidate)
+		}
+	}
+
+	extend([]graph.Identifier{root}, ext)
+
+	return counts
+}
+
+// inNeighborhood reports whether a node belongs to the subgraph or is adjacent to one of its nodes.
+func (c *graphletCounter) inNeighborhood(sub []graph.Identifier, node graph.Identifier) bool {
+	for _, v := range sub {
+		if v == node || c.neighbors[v][node] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// classify returns the motif name of a connected subgraph with three or four nodes.
+func (c *graphletCounter) classify(sub []graph.Identifier) string {
+	edges, maxDegree := 0, 0
+
+	for _, a := range sub {
+		degree := 0
+		for _, b := range sub {
+			if c.neighbors[a][b] {
+				degree++
+			}
+		}
+
+		edges += degree
+		maxDegree = max(maxDegree, degree)
+	}
+
+	edges /= 2
+
+	if len(sub) == 3 {
+		if c.arcs != nil {
+			return c.classifyDirected(sub, edges)
+		}
+
+		if edges == 3 {
+			return "triangle"
+		}
+
+		return "path3"
+	}
+
+	switch {
+	case edges == 3 && maxDegree == 3:
+		return "star4"
+	case edges == 3:
+		return "path4"
+	case edges == 4 && maxDegree == 3:
+		return "tailed-triangle"
+	case edges == 4:
+		return "cycle4"
+	case edges == 5:
+		return "diamond"
+	default:
+		return "clique4"
+	}
+}
+
+// classifyDirected returns the directed motif name of a connected subgraph with three nodes.
+func (c *graphletCounter) classifyDirected(sub []graph.Identifier, edges int) string {
+	out, in := make([]int, 3), make([]int, 3)
+
+	for i, a := range sub {
+		for j, b := range sub {
+			if !c.arcs[a][b] {
+				continue
+			}
+
+			if c.arcs[b][a] {
+				return "mutual3"
+			}
+
+			out[i]++
+			in[j]++
+		}
+	}
+
+	for i := range sub {
+		switch {
+		case edges == 2 && out[i] == 2:
+			return "fan-out"
+		case edges == 2 && in[i] == 2:
+			return "fan-in"
+		case edges == 3 && out[i] == 2:
+			return "feed-forward"
+		}
+	}
+
+	if edges == 3 {
+		return "cycle3"
+	}
+
+	return "chain3"
+}
@@ -0,0 +1,167 @@
+package algorithm
+
+import (
+	"math"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// HITS computes the hub and authority scores of each node in the graph for a Unit.
+// A good authority is pointed to by many good hubs, and a good hub points to many good authorities.
+// Authorities accumulate the hub scores of their in-links, hubs accumulate the authority scores of their out-links.
+//
+// Parameters:
+//   - g: The graph to compute the scores for. Edge weights scale the contributions.
+//   - maxIter: The maximum number of iterations.
+//   - tol: The convergence tolerance on the summed L1 change of both score vectors.
+//
+// Returns:
+//   - hubs: A map where the keys are node identifiers and the values are the hub scores.
+//   - authorities: A map where the keys are node identifiers and the values are the authority scores.
+func (u *Unit) HITS(g *graph.Graph, maxIter int, tol float64) (hubs, authorities map[graph.Identifier]float64) {
+	matrix := g.ToMatrix()
+	n := len(matrix)
+
+	// Initialize both score vectors with 1/n
+	hub := make([]float64, n)
+	auth := make([]float64, n)
+	for i := 0; i < n; i++ {
+		hub[i] = 1.0 / float64(n)
+		auth[i] = 1.0 / float64(n)
+	}
+
+	for iter := 0; iter < maxIter; iter++ {
+		newAuth := make([]float64, n)
+		newHub := make([]float64, n)
+
+		// Authorities collect hub scores along in-links.
+		for j := 0; j < n; j++ {
+			for i := 0; i < n; i++ {
+				if i != j && matrix[i][j] != graph.INF {
+					newAuth[j] += float64(matrix[i][j].Int()) * hub[i]
+				}
+			}
+		}
+
+		// Hubs collect the updated authority scores along out-links.
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if i != j && matrix[i][j] != graph.INF {
+					newHub[i] += float64(matrix[i][j].Int()) * newAuth[j]
+				}
+			}
+		}
+
+		normalizeL2(newAuth)
+		normalizeL2(newHub)
+
+		// Check for convergence
+		diff := l1Distance(newAuth, auth) + l1Distance(newHub, hub)
+
+		auth, hub = newAuth, newHub
+
+		if diff < tol {
+			break
+		}
+	}
+
+	return toScoreMap(hub), toScoreMap(auth)
+}
+
+// HITS computes the hub and authority scores of each node in the graph for a ParallelUnit.
+// The computation is performed in parallel for better performance on larger graphs.
+//
+// Parameters:
+//   - g: The graph to compute the scores for. Edge weights scale the contributions.
+//   - maxIter: The maximum number of iterations.
+//   - tol: The convergence tolerance on the summed L1 change of both score vectors.
+//
+// Returns:
+//   - hubs: A map where the keys are node identifiers and the values are the hub scores.
+//   - authorities: A map where the keys are node identifiers and the values are the authority scores.
+func (pu *ParallelUnit) HITS(g *graph.Graph, maxIter int, tol float64) (hubs, authorities map[graph.Identifier]float64) {
+	matrix := g.ToMatrix()
+	n := len(matrix)
+
+	// Initialize both score vectors with 1/n
+	hub := make([]float64, n)
+	auth := make([]float64, n)
+	for i := 0; i < n; i++ {
+		hub[i] = 1.0 / float64(n)
+		auth[i] = 1.0 / float64(n)
+	}
+
+	for iter := 0; iter < maxIter; iter++ {
+		newAuth := make([]float64, n)
+		newHub := make([]float64, n)
+
+		// Authorities collect hub scores along in-links, one node per job.
+		pu.parallelFor(n, func(node int) {
+			for i := 0; i < n; i++ {
+				if i != node && matrix[i][node] != graph.INF {
+					newAuth[node] += float64(matrix[i][node].Int()) * hub[i]
+				}
+			}
+		})
+
+		// Hubs collect the updated authority scores along out-links, one node per job.
+		pu.parallelFor(n, func(node int) {
+			for j := 0; j < n; j++ {
+				if node != j && matrix[node][j] != graph.INF {
+					newHub[node] += float64(matrix[node][j].Int()) * newAuth[j]
+				}
+			}
+		})
+
+		normalizeL2(newAuth)
+		normalizeL2(newHub)
+
+		// Check for convergence
+		diff := l1Distance(newAuth, auth) + l1Distance(newHub, hub)
+
+		auth, hub = newAuth, newHub
+
+		if diff < tol {
+			break
+		}
+	}
+
+	return toScoreMap(hub), toScoreMap(auth)
+}
+
+// normalizeL2 scales the vector to unit Euclidean length in place. A zero vector is left unchanged.
+func normalizeL2(vector []float64) {
+	norm := 0.0
+	for _, value := range vector {
+		norm += value * value
+	}
+	norm = math.Sqrt(norm)
+
+	if norm == 0 {
+		return
+	}
+
+	for i := range vector {
+		vector[i] /= norm
+	}
+}
+
+// l1Distance returns the sum of absolute differences between two vectors of equal length.
+func l1Distance(a, b []float64) float64 {
+	diff := 0.0
+	for i := range a {
+		diff += math.Abs(a[i] - b[i])
+	}
+
+	return diff
+}
+
+// toScoreMap converts a score vector indexed by identifier into a map.
+func toScoreMap(vector []float64) map[graph.Identifier]float64 {
+	result := make(map[graph.Identifier]float64, len(vector))
+	for i, value := range vector {
+		result[graph.Identifier(i)] = value
+	}
+
+	return result
+}
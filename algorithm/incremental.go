@@ -0,0 +1,268 @@
+package algorithm
+
+import (
+	"fmt"
+	"sort"
+
+	err "github.com/elecbug/go-graphtric/err" // Custom error package
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// UpdateEdge changes the weight of an existing edge and incrementally repairs the cached shortest paths of a Unit.
+// Instead of discarding every path, only the pairs that the change can affect are recomputed.
+//
+// Parameters:
+//   - g: The graph containing the edge.
+//   - from: The identifier of the source node of the edge.
+//   - to: The identifier of the destination node of the edge.
+//   - newWeight: The new, non-negative weight of the edge.
+//
+// Returns:
+//   - An error if the weight is negative or the edge cannot be updated in the graph.
+//
+// Notes:
+//   - Weight increase: only cached paths that traverse the edge can become longer, so exactly those pairs are recomputed.
+//   - Weight decrease: every pair may improve, but an improved path must use the edge.
+//     Distances to `from` and from `to` cannot change, so each pair is relaxed with d(s, from) + newWeight + d(to, t).
+//   - On a multigraph the first parallel edge is updated, as with SetEdgeWeight; the repair stays exact
+//     whichever of the parallel edges the cached paths use.
+//   - Both cases assume non-negative weights and a cache that matched the graph before the call.
+//     If the cache was already stale, or the Unit is compact, the edge is updated and the paths are
+//     recomputed lazily by the next metric call.
+func (u *Unit) UpdateEdge(g *graph.Graph, from, to graph.Identifier, newWeight int64) error {
+	if newWeight < 0 {
+		return err.InvalidEdge(g.Type().String(), fmt.Sprintf("weight: %d", newWeight))
+	}
+
+	node, e := g.FindNode(from)
+	if e != nil {
+		return e
+	}
+
+	// SetEdgeWeight changes the first of several parallel edges, so that is the weight being replaced.
+	oldWeight := graph.INF
+	for _, edge := range node.Edges() {
+		if edge.To() == to {
+			oldWeight = edge.Distance()
+			break
+		}
+	}
+
+	cached := g.Updated() && u.updated && !u.compact
+
+	if e := g.SetEdgeWeight(from, to, graph.Distance(newWeight)); e != nil {
+		return e
+	}
+
+	if !cached {
+		return nil
+	}
+
+	if graph.Distance(newWeight) >= oldWeight {
+		u.repairIncrease(g, from, to)
+	} else {
+		u.repairDecrease(g, from, to, graph.Distance(newWeight))
+	}
+
+	u.markComputed(g)
+
+	return nil
+}
+
+// RemoveEdge removes an edge from the graph and incrementally repairs the cached shortest paths of a Unit.
+// Removing an edge is the limit of increasing its weight, so only cached paths that traverse it are recomputed;
+// pairs that become unreachable are dropped from the cache.
+//
+// Parameters:
+//   - g: The graph containing the edge.
+//   - from: The identifier of the source node of the edge.
+//   - to: The identifier of the destination node of the edge.
+//
+// Returns:
+//   - An error if the edge cannot be removed from the graph.
+func (u *Unit) RemoveEdge(g *graph.Graph, from, to graph.Identifier) error {
+	cached := g.Updated() && u.updated && !u.compact
+
+	if e := g.RemoveEdge(from, to); e != nil {
+		return e
+	}
+
+	if !cached {
+		return nil
+	}
+
+	u.repairIncrease(g, from, to)
+
+	u.markComputed(g)
+
+	return nil
+}
+
+// repairIncrease recomputes every cached path that traverses the edge (from, to).
+// The affected pairs are grouped by source, so each affected source runs a single search.
+//
+// Parameters:
+//   - g: The graph after the weight change.
+//   - from: The identifier of the source node of the edge.
+//   - to: The identifier of the destination node of the edge.
+func (u *Unit) repairIncrease(g *graph.Graph, from, to graph.Identifier) {
+	kept := make([]graph.Path, 0, len(u.shortestPaths))
+	affected := make(map[graph.Identifier][]graph.Identifier)
+
+	for _, path := range u.shortestPaths {
+		if !traversesEdge(path, from, to, !g.Directed()) {
+			kept = append(kept, path)
+			continue
+		}
+
+		nodes := path.Nodes()
+		affected[nodes[0]] = append(affected[nodes[0]], nodes[len(nodes)-1])
+	}
+
+	changed := []graph.Path{}
+
+	for source, targets := range affected {
+		tree := searchFrom(g, source, source, false)
+
+		for _, target := range targets {
+			if d, ok := tree.dist[target]; ok {
+				changed = append(changed, *graph.NewPath(d, tracePath(tree.prev, source, target)))
+			}
+		}
+	}
+
+	u.mergePaths(kept, changed)
+}
+
+// repairDecrease relaxes every pair through the edge (from, to) after its weight decreased.
+//
+// Parameters:
+//   - g: The graph after the weight change.
+//   - from: The identifier of the source node of the edge.
+//   - to: The identifier of the destination node of the edge.
+//   - weight: The new weight of the edge.
+func (u *Unit) repairDecrease(g *graph.Graph, from, to graph.Identifier, weight graph.Distance) {
+	improved := make(map[int]bool) // Positions of the paths replaced or appended below.
+	index := make(map[[2]graph.Identifier]int, len(u.shortestPaths))
+	for i, path := range u.shortestPaths {
+		nodes := path.Nodes()
+		index[[2]graph.Identifier{nodes[0], nodes[len(nodes)-1]}] = i
+	}
+
+	// lookup returns the cached path between two nodes, treating a node as reachable from itself.
+	lookup := func(s, t graph.Identifier) (graph.Path, bool) {
+		if s == t {
+			return *graph.NewPath(0, []graph.Identifier{s}), true
+		}
+
+		i, ok := index[[2]graph.Identifier{s, t}]
+		if !ok {
+			return graph.Path{}, false
+		}
+
+		return u.shortestPaths[i], true
+	}
+
+	relax := func(a, b graph.Identifier) {
+		for _, s := range g.NodeIDs() {
+			head, ok := lookup(s, a)
+			if !ok {
+				continue
+			}
+
+			for _, t := range g.NodeIDs() {
+				if s == t {
+					continue
+				}
+
+				tail, ok := lookup(b, t)
+				if !ok {
+					continue
+				}
+
+				distance := head.Distance() + weight + tail.Distance()
+				i, exists := index[[2]graph.Identifier{s, t}]
+
+				if exists && u.shortestPaths[i].Distance() <= distance {
+					continue
+				}
+
+				nodes := append(append([]graph.Identifier{}, head.Nodes()...), tail.Nodes()...)
+				path := *graph.NewPath(distance, nodes)
+
+				if exists {
+					u.shortestPaths[i] = path
+				} else {
+					i = len(u.shortestPaths)
+					index[[2]graph.Identifier{s, t}] = i
+					u.shortestPaths = append(u.shortestPaths, path)
+				}
+
+				improved[i] = true
+			}
+		}
+	}
+
+	relax(from, to)
+
+	// Undirected edges can be traversed in both directions.
+	if !g.Directed() {
+		relax(to, from)
+	}
+
+	kept := make([]graph.Path, 0, len(u.shortestPaths)-len(improved))
+	changed := make([]graph.Path, 0, len(improved))
+
+	for i, path := range u.shortestPaths {
+		if improved[i] {
+			changed = append(changed, path)
+		} else {
+			kept = append(kept, path)
+		}
+	}
+
+	u.mergePaths(kept, changed)
+}
+
+// mergePaths stores the cached paths in the order of computePaths, given the unchanged paths, which are
+// still in that order, and the repaired ones. Only the repaired paths are sorted, so a small repair does not
+// re-sort the whole cache.
+func (u *Unit) mergePaths(kept, changed []graph.Path) {
+	sort.Slice(changed, func(i, j int) bool {
+		return lessPath(changed[i], changed[j])
+	})
+
+	merged := make([]graph.Path, 0, len(kept)+len(changed))
+	i, j := 0, 0
+
+	for i < len(kept) && j < len(changed) {
+		if lessPath(changed[j], kept[i]) {
+			merged = append(merged, changed[j])
+			j++
+		} else {
+			merged = append(merged, kept[i])
+			i++
+		}
+	}
+
+	merged = append(merged, kept[i:]...)
+	merged = append(merged, changed[j:]...)
+
+	u.shortestPaths = merged
+}
+
+// traversesEdge reports whether the path uses the edge (from, to), in either direction if undirected.
+func traversesEdge(path graph.Path, from, to graph.Identifier, undirected bool) bool {
+	nodes := path.Nodes()
+
+	for i := 0; i+1 < len(nodes); i++ {
+		if nodes[i] == from && nodes[i+1] == to {
+			return true
+		}
+		if undirected && nodes[i] == to && nodes[i+1] == from {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,275 @@
+package algorithm
+
+import (
+	"context"
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// isoCheckInterval is the number of backtracking steps between cancellation checks.
+const isoCheckInterval = 1024
+
+// AreIsomorphic reports whether two graphs are isomorphic.
+// Two graphs are isomorphic if there is a bijection between their nodes that preserves
+// every edge, its direction, and its weight.
+//
+// Parameters:
+//   - a: The first graph.
+//   - b: The second graph.
+//
+// Returns:
+//   - true if the graphs are isomorphic, false otherwise.
+//
+// Notes:
+//   - The check prunes candidates with degree and neighborhood invariants before running a
+//     backtracking vertex mapping (VF2-lite), but its worst case is still exponential.
+//   - It is practical for graphs up to a few hundred nodes; highly regular graphs
+//     (e.g. strongly regular graphs) are the hardest inputs. Use AreIsomorphicContext to bound the run time.
+func AreIsomorphic(a, b *graph.Graph) bool {
+	result, _ := AreIsomorphicContext(context.Background(), a, b)
+	return result
+}
+
+// AreIsomorphicContext reports whether two graphs are isomorphic, observing ctx for cancellation.
+//
+// Parameters:
+//   - ctx: The context used to abort the search.
+//   - a: The first graph.
+//   - b: The second graph.
+//
+// Returns:
+//   - true if the graphs are isomorphic, false otherwise.
+//   - ctx.Err() if the context is cancelled before the search completes.
+func AreIsomorphicContext(ctx context.Context, a, b *graph.Graph) (bool, error) {
+	if a.NodeCount() != b.NodeCount() || a.EdgeCount() != b.EdgeCount() {
+		return false, nil
+	}
+
+	if a.Directed() != b.Directed() {
+		return false, nil
+	}
+
+	ma, mb := newIsoMatrix(a), newIsoMatrix(b)
+	n := len(ma.ids)
+
+	// Compare the multisets of node invariants first; a mismatch rules out isomorphism cheaply.
+	invA, invB := ma.invariants(), mb.invariants()
+	sortedA := append([]isoInvariant{}, invA...)
+	sortedB := append([]isoInvariant{}, invB...)
+	sortInvariants(sortedA)
+	sortInvariants(sortedB)
+
+	for i := range sortedA {
+		if !sortedA[i].equal(sortedB[i]) {
+			return false, nil
+		}
+	}
+
+	// Visit nodes of a in an order where each node is adjacent to as many
+	// already-mapped nodes as possible, so inconsistencies are detected early.
+	order := ma.matchOrder(invA)
+
+	mapping := make([]int, n) // a index -> b index
+	used := make([]bool, n)   // b index already mapped
+	for i := range mapping {
+		mapping[i] = -1
+	}
+
+	steps := 0
+
+	var match func(depth int) (bool, error)
+	match = func(depth int) (bool, error) {
+		if depth == n {
+			return true, nil
+		}
+
+		steps++
+		if steps%isoCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return false, err
+			}
+		}
+
+		v := order[depth]
+
+		for c := 0; c < n; c++ {
+			if used[c] || !invA[v].equal(invB[c]) {
+				continue
+			}
+
+			if !isoFeasible(ma, mb, mapping, order[:depth], v, c) {
+				continue
+			}
+
+			mapping[v] = c
+			used[c] = true
+
+			ok, err := match(depth + 1)
+			if ok || err != nil {
+				return ok, err
+			}
+
+			mapping[v] = -1
+			used[c] = false
+		}
+
+		return false, nil
+	}
+
+	return match(0)
+}
+
+// isoMatrix is a compact adjacency matrix indexed by position in ids rather than by Identifier.
+type isoMatrix struct {
+	ids    []graph.Identifier // Node identifiers in ascending order.
+	weight [][]graph.Distance // Edge weights between positions, INF where no edge exists.
+}
+
+// newIsoMatrix builds an isoMatrix from the live nodes of g.
+func newIsoMatrix(g *graph.Graph) isoMatrix {
+	ids := g.NodeIDs()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	matrix := g.ToMatrix()
+	weight := make([][]graph.Distance, len(ids))
+
+	for i, from := range ids {
+		weight[i] = make([]graph.Distance, len(ids))
+
+		// Zero-weight edges are edges like any other; only INF marks a missing one, as in richClubDegrees.
+		for j, to := range ids {
+			weight[i][j] = matrix[from][to]
+		}
+	}
+
+	return isoMatrix{ids: ids, weight: weight}
+}
+
+// isoInvariant holds label-independent properties of a node that any isomorphism must preserve.
+type isoInvariant struct {
+	out, in   int   // Out-degree and in-degree of the node.
+	neighbors []int // Sorted out-degrees of the node's out-neighbors.
+}
+
+// equal reports whether two invariants are identical.
+func (inv isoInvariant) equal(other isoInvariant) bool {
+	if inv.out != other.out || inv.in != other.in || len(inv.neighbors) != len(other.neighbors) {
+		return false
+	}
+
+	for i := range inv.neighbors {
+		if inv.neighbors[i] != other.neighbors[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// invariants computes the isoInvariant of every position in the matrix.
+func (m isoMatrix) invariants() []isoInvariant {
+	n := len(m.ids)
+	result := make([]isoInvariant, n)
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if m.weight[i][j] != graph.INF {
+				result[i].out++
+				result[j].in++
+			}
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		result[i].neighbors = []int{}
+
+		for j := 0; j < n; j++ {
+			if m.weight[i][j] != graph.INF {
+				result[i].neighbors = append(result[i].neighbors, result[j].out)
+			}
+		}
+
+		sort.Ints(result[i].neighbors)
+	}
+
+	return result
+}
+
+// matchOrder returns the order in which nodes are assigned during backtracking.
+// It greedily picks the node with the most connections to already-ordered nodes,
+// breaking ties by higher degree.
+func (m isoMatrix) matchOrder(inv []isoInvariant) []int {
+	n := len(m.ids)
+	order := make([]int, 0, n)
+	placed := make([]bool, n)
+	links := make([]int, n)
+
+	for len(order) < n {
+		best := -1
+
+		for v := 0; v < n; v++ {
+			if placed[v] {
+				continue
+			}
+
+			if best == -1 || links[v] > links[best] ||
+				(links[v] == links[best] && inv[v].out+inv[v].in > inv[best].out+inv[best].in) {
+				best = v
+			}
+		}
+
+		placed[best] = true
+		order = append(order, best)
+
+		for u := 0; u < n; u++ {
+			if m.weight[best][u] != graph.INF || m.weight[u][best] != graph.INF {
+				links[u]++
+			}
+		}
+	}
+
+	return order
+}
+
+// sortInvariants sorts invariants into a canonical order so two multisets can be compared element-wise.
+func sortInvariants(invs []isoInvariant) {
+	sort.Slice(invs, func(i, j int) bool {
+		a, b := invs[i], invs[j]
+
+		if a.out != b.out {
+			return a.out < b.out
+		}
+		if a.in != b.in {
+			return a.in < b.in
+		}
+		if len(a.neighbors) != len(b.neighbors) {
+			return len(a.neighbors) < len(b.neighbors)
+		}
+
+		for k := range a.neighbors {
+			if a.neighbors[k] != b.neighbors[k] {
+				return a.neighbors[k] < b.neighbors[k]
+			}
+		}
+
+		return false
+	})
+}
+
+// isoFeasible reports whether mapping v (in a) to c (in b) is consistent with the nodes mapped so far.
+func isoFeasible(ma, mb isoMatrix, mapping []int, mapped []int, v, c int) bool {
+	if ma.weight[v][v] != mb.weight[c][c] {
+		return false
+	}
+
+	for _, x := range mapped {
+		y := mapping[x]
+
+		if ma.weight[v][x] != mb.weight[c][y] || ma.weight[x][v] != mb.weight[y][c] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,93 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// KCoreDecomposition computes the coreness of every node in the graph.
+// The coreness of a node is the largest k such that the node belongs to the k-core,
+// the maximal subgraph in which every node has degree at least k.
+//
+// Parameters:
+//   - g: The graph to decompose. Edge directions are ignored.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are their coreness.
+//
+// Notes:
+//   - Nodes are peeled in order of their current minimum degree (Batagelj-Zaversnik), which runs in O(n + m).
+func KCoreDecomposition(g *graph.Graph) map[graph.Identifier]int {
+	neighbors := undirectedNeighbors(g)
+	ids := sortedIDs(g)
+
+	degree := make(map[graph.Identifier]int, len(ids))
+	maxDegree := 0
+
+	for _, id := range ids {
+		degree[id] = len(neighbors[id])
+		if degree[id] > maxDegree {
+			maxDegree = degree[id]
+		}
+	}
+
+	// Bucket nodes by their current degree.
+	buckets := make([][]graph.Identifier, maxDegree+1)
+	for _, id := range ids {
+		buckets[degree[id]] = append(buckets[degree[id]], id)
+	}
+
+	coreness := make(map[graph.Identifier]int, len(ids))
+	removed := make(map[graph.Identifier]bool, len(ids))
+
+	for k := 0; k <= maxDegree; k++ {
+		// Buckets at or below k may grow while peeling, so drain them until empty.
+		for len(buckets[k]) > 0 {
+			node := buckets[k][len(buckets[k])-1]
+			buckets[k] = buckets[k][:len(buckets[k])-1]
+
+			// Skip stale bucket entries for nodes already removed or moved to a lower bucket.
+			if removed[node] || degree[node] != k {
+				continue
+			}
+
+			removed[node] = true
+			coreness[node] = k
+
+			for neighbor := range neighbors[node] {
+				if removed[neighbor] || degree[neighbor] <= k {
+					continue
+				}
+
+				degree[neighbor]--
+				buckets[degree[neighbor]] = append(buckets[degree[neighbor]], neighbor)
+			}
+		}
+	}
+
+	return coreness
+}
+
+// KCore returns the nodes of the k-core of the graph.
+// The k-core is the maximal subgraph in which every node has degree at least k.
+//
+// Parameters:
+//   - g: The graph to extract the k-core from. Edge directions are ignored.
+//   - k: The minimum degree of the core.
+//
+// Returns:
+//   - A slice of node identifiers in the k-core, sorted in ascending order. Empty if the k-core does not exist.
+func KCore(g *graph.Graph, k int) []graph.Identifier {
+	result := []graph.Identifier{}
+
+	for node, core := range KCoreDecomposition(g) {
+		if core >= k {
+			result = append(result, node)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+
+	return result
+}
@@ -0,0 +1,130 @@
+package algorithm
+
+import (
+	"math"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// JaccardSimilarity computes the Jaccard similarity of the neighborhoods of two nodes.
+// It is the size of the intersection of their neighbor sets divided by the size of the union.
+//
+// Parameters:
+//   - g: The graph containing the nodes. Edge directions are ignored.
+//   - a: The identifier of the first node.
+//   - b: The identifier of the second node.
+//
+// Returns:
+//   - The similarity in [0, 1]; 0 if both neighborhoods are empty.
+func JaccardSimilarity(g *graph.Graph, a, b graph.Identifier) float64 {
+	neighbors := undirectedNeighbors(g)
+	return jaccard(neighbors, a, b, len(sharedNeighbors(neighbors, a, b)))
+}
+
+// AllPairsJaccard computes the Jaccard similarity of every pair of nodes that share at least one neighbor.
+// Pairs with a similarity of 0 are omitted, so the result stays small on sparse graphs.
+//
+// Parameters:
+//   - g: The graph to compute the similarities for. Edge directions are ignored.
+//
+// Returns:
+//   - A map keyed by node pairs {a, b} with a < b, whose values are the nonzero similarities.
+func AllPairsJaccard(g *graph.Graph) map[[2]graph.Identifier]float64 {
+	neighbors := undirectedNeighbors(g)
+	shared := make(map[[2]graph.Identifier]int)
+
+	// Every node contributes one shared neighbor to each pair of its own neighbors.
+	for _, adjacent := range neighbors {
+		list := make([]graph.Identifier, 0, len(adjacent))
+		for n := range adjacent {
+			list = append(list, n)
+		}
+
+		for i := 0; i < len(list); i++ {
+			for j := i + 1; j < len(list); j++ {
+				shared[orderedPair(list[i], list[j])]++
+			}
+		}
+	}
+
+	result := make(map[[2]graph.Identifier]float64, len(shared))
+	for pair, count := range shared {
+		result[pair] = jaccard(neighbors, pair[0], pair[1], count)
+	}
+
+	return result
+}
+
+// CommonNeighbors counts the neighbors shared by two nodes.
+// A high count suggests a missing link between the nodes.
+//
+// Parameters:
+//   - g: The graph containing the nodes. Edge directions are ignored.
+//   - a: The identifier of the first node.
+//   - b: The identifier of the second node.
+//
+// Returns:
+//   - The number of nodes adjacent to both a and b.
+func CommonNeighbors(g *graph.Graph, a, b graph.Identifier) int {
+	return len(sharedNeighbors(undirectedNeighbors(g), a, b))
+}
+
+// AdamicAdar computes the Adamic-Adar index of two nodes.
+// Each shared neighbor z contributes 1/log(degree(z)), so rare (low-degree) shared neighbors weigh more.
+//
+// Parameters:
+//   - g: The graph containing the nodes. Edge directions are ignored.
+//   - a: The identifier of the first node.
+//   - b: The identifier of the second node.
+//
+// Returns:
+//   - The Adamic-Adar index; 0 if the nodes share no neighbors.
+//
+// Notes:
+//   - Shared neighbors of degree 1 (only possible when a == b) are skipped, since log(1) = 0.
+func AdamicAdar(g *graph.Graph, a, b graph.Identifier) float64 {
+	neighbors := undirectedNeighbors(g)
+	score := 0.0
+
+	for _, z := range sharedNeighbors(neighbors, a, b) {
+		degree := len(neighbors[z])
+
+		if degree > 1 {
+			score += 1.0 / math.Log(float64(degree))
+		}
+	}
+
+	return score
+}
+
+// jaccard computes the Jaccard similarity from the neighbor sets and the size of their intersection.
+func jaccard(neighbors map[graph.Identifier]map[graph.Identifier]bool, a, b graph.Identifier, shared int) float64 {
+	union := len(neighbors[a]) + len(neighbors[b]) - shared
+	if union == 0 {
+		return 0.0
+	}
+
+	return float64(shared) / float64(union)
+}
+
+// sharedNeighbors returns the nodes adjacent to both a and b.
+func sharedNeighbors(neighbors map[graph.Identifier]map[graph.Identifier]bool, a, b graph.Identifier) []graph.Identifier {
+	result := []graph.Identifier{}
+
+	for n := range neighbors[a] {
+		if neighbors[b][n] {
+			result = append(result, n)
+		}
+	}
+
+	return result
+}
+
+// orderedPair returns the pair {a, b} with the smaller identifier first.
+func orderedPair(a, b graph.Identifier) [2]graph.Identifier {
+	if a > b {
+		a, b = b, a
+	}
+
+	return [2]graph.Identifier{a, b}
+}
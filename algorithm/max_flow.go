@@ -0,0 +1,385 @@
+package algorithm
+
+import (
+	"math/rand"
+
+	err "github.com/elecbug/go-graphtric/err" // Custom error package
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// flowNetwork is a dense capacity matrix over the nodes of a graph, indexed by their position in ids.
+type flowNetwork struct {
+	ids      []graph.Identifier       // Node identifiers in ascending order.
+	index    map[graph.Identifier]int // Position of each identifier in ids.
+	capacity [][]int64                // Capacity of each ordered pair of positions.
+}
+
+// newFlowNetwork builds the capacity matrix of a graph from its edge weights; unweighted edges have capacity 1.
+// If symmetric is set, the capacities of both directions of a directed graph are summed,
+// so that the network describes an undirected graph.
+func newFlowNetwork(g *graph.Graph, symmetric bool) *flowNetwork {
+	ids := sortedIDs(g)
+	network := &flowNetwork{
+		ids:      ids,
+		index:    make(map[graph.Identifier]int, len(ids)),
+		capacity: make([][]int64, len(ids)),
+	}
+
+	for i, id := range ids {
+		network.index[id] = i
+		network.capacity[i] = make([]int64, len(ids))
+	}
+
+	for _, id := range ids {
+		for _, e := range g.NeighborEdges(id) {
+			from, to := network.index[id], network.index[e.To()]
+			network.capacity[from][to] += int64(e.Distance())
+
+			if symmetric && g.Directed() {
+				network.capacity[to][from] += int64(e.Distance())
+			}
+		}
+	}
+
+	return network
+}
+
+// maxFlow computes a maximum flow between two positions with the Edmonds-Karp algorithm,
+// augmenting along shortest residual paths found by breadth-first search.
+//
+// Returns:
+//   - The value of the maximum flow.
+//   - The flow on each ordered pair of positions, net of the flow in the opposite direction.
+//   - The positions reachable from the source in the final residual network, i.e. the source side of a minimum cut.
+func (network *flowNetwork) maxFlow(source, sink int) (int64, [][]int64, []bool) {
+	n := len(network.ids)
+	flow := make([][]int64, n)
+	for i := range flow {
+		flow[i] = make([]int64, n)
+	}
+
+	var total int64
+
+	for {
+		// Breadth-first search for an augmenting path in the residual network.
+		parent := make([]int, n)
+		for i := range parent {
+			parent[i] = -1
+		}
+
+		parent[source] = source
+		queue := []int{source}
+
+		for len(queue) > 0 && parent[sink] == -1 {
+			v := queue[0]
+			queue = queue[1:]
+
+			for w := 0; w < n; w++ {
+				if parent[w] == -1 && network.capacity[v][w]-flow[v][w] > 0 {
+					parent[w] = v
+					queue = append(queue, w)
+				}
+			}
+		}
+
+		if parent[sink] == -1 {
+			reached := make([]bool, n)
+			for i, p := range parent {
+				reached[i] = p != -1
+			}
+
+			return total, flow, reached
+		}
+
+		// Push the bottleneck capacity along the path.
+		bottleneck := INF64
+		for w := sink; w != source; w = parent[w] {
+			v := parent[w]
+			bottleneck = min(bottleneck, network.capacity[v][w]-flow[v][w])
+		}
+
+		for w := sink; w != source; w = parent[w] {
+			v := parent[w]
+			flow[v][w] += bottleneck
+			flow[w][v] -= bottleneck
+		}
+
+		total += bottleneck
+	}
+}
+
+// MaxFlow computes the value of a maximum flow from a source to a sink, treating edge weights as capacities.
+// By the max-flow min-cut theorem, this also equals the weight of a minimum cut separating the sink from the source.
+//
+// Parameters:
+//   - g: The flow network. Unweighted edges have capacity 1; undirected edges carry flow in either direction.
+//   - source: The identifier of the source node.
+//   - sink: The identifier of the sink node.
+//
+// Returns:
+//   - The value of the maximum flow, or 0 if either node does not exist or they are the same node.
+//
+// Notes:
+//   - It uses the Edmonds-Karp algorithm on a dense capacity matrix, O(n³m) in the worst case.
+func MaxFlow(g *graph.Graph, source, sink graph.Identifier) int64 {
+	network := newFlowNetwork(g, false)
+
+	s, okSource := network.index[source]
+	t, okSink := network.index[sink]
+
+	if !okSource || !okSink || s == t {
+		return 0
+	}
+
+	value, _, _ := network.maxFlow(s, t)
+
+	return value
+}
+
+// flowArc is an arc of a residual network; the arc at index i^1 is its reverse.
+type flowArc struct {
+	to       int   // Position of the head of the arc.
+	capacity int64 // Remaining capacity of the arc.
+	cost     int64 // Cost per unit of flow.
+}
+
+// MinCostMaxFlow computes a maximum flow from a source to a sink whose total cost is minimal,
+// using successive shortest augmenting paths. Each augmenting path is the cheapest path of the residual network,
+// found with the queue-based Bellman-Ford algorithm (SPFA) because reverse arcs have negative costs.
+//
+// Parameters:
+//   - g: The flow network. Capacities are the edge weights, as for MaxFlow: unweighted edges have capacity 1,
+//     undirected edges carry flow in either direction, and parallel edges add up their capacities.
+//   - cost: The cost per unit of flow of each edge, indexed by node identifier like ToMatrix. Entries outside
+//     the matrix count as 0. Parallel edges share the cost of their node pair.
+//   - source: The identifier of the source node.
+//   - sink: The identifier of the sink node.
+//
+// Returns:
+//   - The value of the maximum flow, equal to MaxFlow.
+//   - The total cost of the flow, the sum of flow times cost over all edges.
+//   - The flow on each edge, indexed by node identifier like ToMatrix. For undirected graphs the two directions
+//     of an edge are separate arcs, so flowMatrix[i][j] and flowMatrix[j][i] are reported separately.
+//     The flows of parallel edges are summed.
+//     If either node does not exist or they are the same node, the flow is 0 and the matrix is all zeros.
+//   - An error if a cycle of positive-capacity arcs with a negative total cost is reachable from the source;
+//     the flow and cost are then 0 and the matrix is all zeros.
+//
+// Notes:
+//   - Costs may be negative as long as no cycle of the network has a negative total cost. In an undirected graph
+//     the two arcs of an edge form such a cycle as soon as their costs sum to less than 0, so a single negative
+//     cost is usually one. Self-loops are ignored.
+//   - Each augmentation costs O(n·a) for a arcs, and there are at most as many augmentations as units of flow.
+func MinCostMaxFlow(g *graph.Graph, cost [][]int64, source, sink graph.Identifier) (int64, int64, [][]int64, error) {
+	matrix := g.ToMatrix()
+	n := len(matrix)
+
+	flowMatrix := make([][]int64, n)
+	for i := range flowMatrix {
+		flowMatrix[i] = make([]int64, n)
+	}
+
+	if _, e := g.FindNode(source); e != nil || source == sink {
+		return 0, 0, flowMatrix, nil
+	}
+	if _, e := g.FindNode(sink); e != nil {
+		return 0, 0, flowMatrix, nil
+	}
+
+	// unitCost returns the cost of the edge (i, j), treating missing entries as 0.
+	unitCost := func(i, j int) int64 {
+		if i < len(cost) && j < len(cost[i]) {
+			return cost[i][j]
+		}
+
+		return 0
+	}
+
+	arcs := []flowArc{}
+	adjacency := make([][]int, n)
+
+	// Every edge becomes its own arc, so parallel edges add up their capacities as in MaxFlow.
+	// Undirected edges are listed from both ends and give one arc per direction.
+	for _, id := range sortedIDs(g) {
+		for _, e := range g.NeighborEdges(id) {
+			i, j := int(id), int(e.To())
+			if i == j || j >= n {
+				continue
+			}
+
+			adjacency[i] = append(adjacency[i], len(arcs))
+			arcs = append(arcs, flowArc{to: j, capacity: int64(e.Distance()), cost: unitCost(i, j)})
+
+			adjacency[j] = append(adjacency[j], len(arcs))
+			arcs = append(arcs, flowArc{to: i, capacity: 0, cost: -unitCost(i, j)})
+		}
+	}
+
+	s, t := int(source), int(sink)
+	var flow, totalCost int64
+
+	for {
+		// Find the cheapest augmenting path; parent holds the arc used to reach each position.
+		dist := make([]int64, n)
+		parent := make([]int, n)
+		queued := make([]bool, n)
+		length := make([]int, n) // Number of arcs on the cheapest known path to each position.
+
+		for i := range dist {
+			dist[i], parent[i] = INF64, -1
+		}
+
+		dist[s] = 0
+		queue := []int{s}
+		queued[s] = true
+
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			queued[v] = false
+
+			for _, a := range adjacency[v] {
+				arc := arcs[a]
+
+				if arc.capacity > 0 && dist[v]+arc.cost < dist[arc.to] {
+					dist[arc.to] = dist[v] + arc.cost
+					parent[arc.to] = a
+
+					// A cheapest path with n arcs repeats a node, so it runs through a negative cycle,
+					// which would keep SPFA relaxing forever. flowMatrix is still all zeros here.
+					if length[arc.to] = length[v] + 1; length[arc.to] >= n {
+						return 0, 0, flowMatrix, err.NegativeCycle(source.String())
+					}
+
+					if !queued[arc.to] {
+						queue = append(queue, arc.to)
+						queued[arc.to] = true
+					}
+				}
+			}
+		}
+
+		if dist[t] == INF64 {
+			break
+		}
+
+		// Push the bottleneck capacity along the path.
+		bottleneck := INF64
+		for v := t; v != s; v = arcs[parent[v]^1].to {
+			bottleneck = min(bottleneck, arcs[parent[v]].capacity)
+		}
+
+		for v := t; v != s; v = arcs[parent[v]^1].to {
+			arcs[parent[v]].capacity -= bottleneck
+			arcs[parent[v]^1].capacity += bottleneck
+		}
+
+		flow += bottleneck
+		totalCost += bottleneck * dist[t]
+	}
+
+	// The flow on an arc is the capacity its reverse arc has gained.
+	for i := 0; i < n; i++ {
+		for _, a := range adjacency[i] {
+			if a%2 == 0 {
+				flowMatrix[i][arcs[a].to] += arcs[a^1].capacity
+			}
+		}
+	}
+
+	return flow, totalCost, flowMatrix, nil
+}
+
+// FlowBetweenness computes the flow betweenness centrality of each node in the graph for a Unit.
+// For every ordered pair (s, t) a maximum flow is pushed from s to t, and each other node is credited with
+// the flow passing through it. Unlike geodesic betweenness, every route that carries part of the maximum flow
+// counts, so a node on a wide detour is credited even if it lies on no shortest path.
+//
+// Parameters:
+//   - g: The flow network. Capacities are the edge weights, as for MaxFlow.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the total flow through each node,
+//     summed over all ordered pairs of other nodes, without normalization.
+//
+// Notes:
+//   - Maximum flows are not unique; the flow through a node is that of the flow found by Edmonds-Karp,
+//     which prefers short augmenting paths, so ties between equally wide routes are split deterministically.
+//   - Undirected graphs count each pair in both directions, carrying the same flow.
+//   - It runs n(n-1) maximum flows; use ApproximateFlowBetweenness to sample the sources on larger graphs.
+func (u *Unit) FlowBetweenness(g *graph.Graph) map[graph.Identifier]float64 {
+	return u.ApproximateFlowBetweenness(g, g.NodeCount(), 0)
+}
+
+// ApproximateFlowBetweenness estimates the flow betweenness centrality of each node by sampling source nodes.
+// Maximum flows are computed from sampleSize randomly chosen sources to every other node only,
+// and the accumulated flows are scaled by n / sampleSize to estimate the full sum.
+//
+// Parameters:
+//   - g: The flow network. Capacities are the edge weights, as for MaxFlow.
+//   - sampleSize: The number of source nodes to sample. Values of n or more use every node (exact FlowBetweenness).
+//   - seed: The seed of the random source selection, making the result reproducible.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the estimated flow betweenness scores,
+//     on the scale of FlowBetweenness.
+//
+// Notes:
+//   - The cost is sampleSize(n-1) maximum flows instead of n(n-1); the estimate is unbiased.
+func (u *Unit) ApproximateFlowBetweenness(g *graph.Graph, sampleSize int, seed int64) map[graph.Identifier]float64 {
+	network := newFlowNetwork(g, false)
+	n := len(network.ids)
+
+	centrality := make(map[graph.Identifier]float64, n)
+	for _, id := range network.ids {
+		centrality[id] = 0
+	}
+
+	if sampleSize <= 0 || n < 3 {
+		return centrality
+	}
+
+	sources := make([]int, n)
+	for i := range sources {
+		sources[i] = i
+	}
+
+	if sampleSize < n {
+		sources = rand.New(rand.NewSource(seed)).Perm(n)[:sampleSize]
+	}
+
+	through := make([]float64, n)
+
+	for _, s := range sources {
+		for t := 0; t < n; t++ {
+			if t == s {
+				continue
+			}
+
+			value, flow, _ := network.maxFlow(s, t)
+			if value == 0 {
+				continue
+			}
+
+			// The flow through v is the flow entering it; net flows are antisymmetric, so only positive entries count.
+			for v := 0; v < n; v++ {
+				if v == s || v == t {
+					continue
+				}
+
+				for w := 0; w < n; w++ {
+					if flow[w][v] > 0 {
+						through[v] += float64(flow[w][v])
+					}
+				}
+			}
+		}
+	}
+
+	scale := float64(n) / float64(len(sources))
+	for v, id := range network.ids {
+		centrality[id] = through[v] * scale
+	}
+
+	return centrality
+}
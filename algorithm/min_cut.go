@@ -0,0 +1,326 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// GlobalMinCut finds a minimum cut of the graph with the Stoer-Wagner algorithm.
+// Unlike an s-t cut, no source or sink is given: the result is the lightest set of edges
+// whose removal splits the graph into two non-empty parts, a measure of network robustness.
+//
+// Parameters:
+//   - g: The graph to cut. Unweighted edges count as weight 1.
+//
+// Returns:
+//   - The total weight of the minimum cut.
+//   - The node identifiers on one side of the cut, in ascending order; nil if the graph has fewer than two nodes.
+//
+// Notes:
+//   - The algorithm is defined on undirected graphs; for directed graphs the weights of
+//     both directions between two nodes are summed.
+//   - It runs n-1 maximum-adjacency phases over a dense matrix, O(n³) in total.
+func GlobalMinCut(g *graph.Graph) (int64, []graph.Identifier) {
+	ids := sortedIDs(g)
+	n := len(ids)
+
+	if n < 2 {
+		return 0, nil
+	}
+
+	index := make(map[graph.Identifier]int, n)
+	for i, id := range ids {
+		index[id] = i
+	}
+
+	weight := make([][]int64, n)
+	for i := range weight {
+		weight[i] = make([]int64, n)
+	}
+
+	for _, id := range ids {
+		for _, e := range g.NeighborEdges(id) {
+			from, to := index[id], index[e.To()]
+			if from == to {
+				continue
+			}
+
+			weight[from][to] += int64(e.Distance())
+
+			if g.Directed() {
+				weight[to][from] += int64(e.Distance())
+			}
+		}
+	}
+
+	// merged[i] lists the original positions contracted into super node i.
+	merged := make([][]int, n)
+	for i := range merged {
+		merged[i] = []int{i}
+	}
+
+	active := make([]int, n)
+	for i := range active {
+		active[i] = i
+	}
+
+	best := INF64
+	var side []int
+
+	for len(active) > 1 {
+		// Maximum-adjacency ordering: repeatedly add the node most tightly connected to the set.
+		added := make([]bool, n)
+		connection := make([]int64, n)
+		prev, last := -1, active[0]
+
+		for range active {
+			next := -1
+			for _, v := range active {
+				if !added[v] && (next == -1 || connection[v] > connection[next]) {
+					next = v
+				}
+			}
+
+			added[next] = true
+			prev, last = last, next
+
+			for _, v := range active {
+				if !added[v] {
+					connection[v] += weight[next][v]
+				}
+			}
+		}
+
+		// The cut of the phase separates the last added node from the rest.
+		if connection[last] < best {
+			best = connection[last]
+			side = append([]int{}, merged[last]...)
+		}
+
+		// Contract the last two nodes of the ordering.
+		merged[prev] = append(merged[prev], merged[last]...)
+		for _, v := range active {
+			weight[prev][v] += weight[last][v]
+			weight[v][prev] = weight[prev][v]
+		}
+
+		for i, v := range active {
+			if v == last {
+				active = append(active[:i], active[i+1:]...)
+				break
+			}
+		}
+	}
+
+	result := make([]graph.Identifier, len(side))
+	for i, position := range side {
+		result[i] = ids[position]
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+
+	return best, result
+}
+
+// GomoryHuTree builds a cut tree that answers minimum-cut queries between all pairs of nodes.
+// For any two nodes, the weight of a minimum cut separating them in g equals the smallest edge weight
+// on the path between them in the tree; see MinCutValue.
+//
+// Parameters:
+//   - g: The graph to analyze. Unweighted edges count as weight 1.
+//
+// Returns:
+//   - An undirected weighted tree over the nodes of g, with the same identifiers and names, whose edge
+//     weights are minimum cut values. Nodes in different components of g are joined by edges of weight 0.
+//
+// Notes:
+//   - The tree is built with Gusfield's algorithm, which needs n-1 maximum flow computations and
+//     no graph contractions.
+//   - The algorithm is defined on undirected graphs; for directed graphs the weights of
+//     both directions between two nodes are summed, as in GlobalMinCut.
+func GomoryHuTree(g *graph.Graph) *graph.Graph {
+	network := newFlowNetwork(g, true)
+	n := len(network.ids)
+
+	// Every node starts attached to the first one; each cut re-hangs the nodes on its source side.
+	parent := make([]int, n)
+	weight := make([]int64, n)
+
+	for s := 1; s < n; s++ {
+		t := parent[s]
+		value, _, side := network.maxFlow(s, t)
+		weight[s] = value
+
+		for i := s + 1; i < n; i++ {
+			if side[i] && parent[i] == t {
+				parent[i] = s
+			}
+		}
+	}
+
+	tree := emptyCopy(g, graph.UndirectedWeighted)
+
+	for s := 1; s < n; s++ {
+		tree.AddWeightEdge(network.ids[s], network.ids[parent[s]], graph.Distance(weight[s]))
+	}
+
+	return tree
+}
+
+// MinCutValue reads the minimum cut value between two nodes from a tree built by GomoryHuTree.
+//
+// Parameters:
+//   - tree: The Gomory-Hu tree.
+//   - a: The identifier of the first node.
+//   - b: The identifier of the second node.
+//
+// Returns:
+//   - The smallest edge weight on the tree path between a and b, or 0 if they are the same node,
+//     either does not exist, or they are not connected in the tree.
+func MinCutValue(tree *graph.Graph, a, b graph.Identifier) int64 {
+	if _, e := tree.FindNode(a); e != nil || a == b {
+		return 0
+	}
+
+	// bottleneck[v] is the smallest edge weight on the tree path from a to v.
+	bottleneck := map[graph.Identifier]int64{a: INF64}
+	queue := []graph.Identifier{a}
+
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+
+		if v == b {
+			return bottleneck[v]
+		}
+
+		for _, e := range tree.NeighborEdges(v) {
+			if _, seen := bottleneck[e.To()]; !seen {
+				bottleneck[e.To()] = min(bottleneck[v], int64(e.Distance()))
+				queue = append(queue, e.To())
+			}
+		}
+	}
+
+	return 0
+}
+
+// EdgeConnectivity computes the edge connectivity λ of the graph: the minimum number of edges whose removal
+// disconnects it. By Menger's theorem, the number of edge-disjoint paths between two nodes equals the size of a
+// minimum edge cut separating them, so λ is the smallest unit-capacity maximum flow from a fixed node.
+//
+// Parameters:
+//   - g: The graph to analyze. Edge weights are ignored.
+//
+// Returns:
+//   - The edge connectivity; 0 if the graph has fewer than two nodes or is disconnected.
+//
+// Notes:
+//   - For directed graphs, λ is the strong edge connectivity: flows are computed in both directions
+//     between the fixed node and every other node.
+//   - Parallel edges each count; self-loops never disconnect anything and are ignored.
+//   - It needs n-1 maximum flow computations (2(n-1) if directed).
+func EdgeConnectivity(g *graph.Graph) int {
+	ids := sortedIDs(g)
+	n := len(ids)
+
+	if n < 2 {
+		return 0
+	}
+
+	network := &flowNetwork{ids: ids, index: make(map[graph.Identifier]int, n), capacity: make([][]int64, n)}
+
+	for i, id := range ids {
+		network.index[id] = i
+		network.capacity[i] = make([]int64, n)
+	}
+
+	for _, id := range ids {
+		for _, to := range g.Neighbors(id) {
+			if to != id {
+				network.capacity[network.index[id]][network.index[to]]++
+			}
+		}
+	}
+
+	lambda := INF64
+
+	for t := 1; t < n; t++ {
+		value, _, _ := network.maxFlow(0, t)
+		lambda = min(lambda, value)
+
+		if g.Directed() {
+			value, _, _ = network.maxFlow(t, 0)
+			lambda = min(lambda, value)
+		}
+	}
+
+	return int(lambda)
+}
+
+// NodeConnectivity computes the node connectivity κ of the graph: the minimum number of nodes whose removal
+// disconnects it. By Menger's theorem, the number of internally node-disjoint paths between two non-adjacent
+// nodes equals the size of a minimum node cut separating them. Every node is split into an entry and an exit
+// joined by an edge of capacity 1, so that a maximum flow counts the node-disjoint paths.
+//
+// Parameters:
+//   - g: The graph to analyze. Edge weights are ignored.
+//
+// Returns:
+//   - The node connectivity; 0 if the graph has fewer than two nodes or is disconnected.
+//     A complete graph cannot be disconnected, and its connectivity is n-1 by convention.
+//
+// Notes:
+//   - For directed graphs, κ is the strong node connectivity over ordered pairs of non-adjacent nodes.
+//   - It needs one maximum flow computation per pair of non-adjacent nodes, so it suits small and medium graphs.
+//   - κ ≤ λ ≤ minimum degree always holds, see EdgeConnectivity.
+func NodeConnectivity(g *graph.Graph) int {
+	ids := sortedIDs(g)
+	n := len(ids)
+
+	if n < 2 {
+		return 0
+	}
+
+	// Position i is the entry of the i-th node and position n+i its exit.
+	network := &flowNetwork{ids: append(append([]graph.Identifier{}, ids...), ids...),
+		index: make(map[graph.Identifier]int, n), capacity: make([][]int64, 2*n)}
+
+	for i, id := range ids {
+		network.index[id] = i
+	}
+	for i := range network.capacity {
+		network.capacity[i] = make([]int64, 2*n)
+	}
+
+	adjacent := make(map[[2]int]bool)
+
+	for i, id := range ids {
+		network.capacity[i][n+i] = 1
+
+		for _, to := range g.Neighbors(id) {
+			if j := network.index[to]; j != i {
+				// Edges between nodes can carry any flow; only the nodes limit it.
+				network.capacity[n+i][j] = int64(n)
+				adjacent[[2]int{i, j}] = true
+			}
+		}
+	}
+
+	kappa := int64(n - 1)
+
+	for s := 0; s < n; s++ {
+		for t := 0; t < n; t++ {
+			if s == t || adjacent[[2]int{s, t}] || (!g.Directed() && t < s) {
+				continue
+			}
+
+			// Leave the source through its exit and reach the sink at its entry, so neither counts as a cut node.
+			value, _, _ := network.maxFlow(n+s, t)
+			kappa = min(kappa, value)
+		}
+	}
+
+	return int(kappa)
+}
@@ -0,0 +1,44 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// undirectedNeighbors builds the neighbor set of every node, ignoring edge direction and self-loops.
+// Edges to removed nodes, which RemoveNode leaves in the adjacency lists of their sources, are ignored as well.
+//
+// Parameters:
+//   - g: The graph to read the adjacency lists from.
+//
+// Returns:
+//   - A map from each node identifier to the set of its neighbors.
+func undirectedNeighbors(g *graph.Graph) map[graph.Identifier]map[graph.Identifier]bool {
+	neighbors := make(map[graph.Identifier]map[graph.Identifier]bool, g.NodeCount())
+
+	for _, id := range g.NodeIDs() {
+		neighbors[id] = make(map[graph.Identifier]bool)
+	}
+
+	for _, id := range g.NodeIDs() {
+		for _, to := range g.Neighbors(id) {
+			if _, exists := neighbors[to]; !exists || to == id {
+				continue
+			}
+
+			neighbors[id][to] = true
+			neighbors[to][id] = true
+		}
+	}
+
+	return neighbors
+}
+
+// sortedIDs returns the node identifiers of the graph in ascending order.
+func sortedIDs(g *graph.Graph) []graph.Identifier {
+	ids := g.NodeIDs()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
@@ -0,0 +1,94 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// WeightedPageRank computes the PageRank of each node in the graph for a Unit, with rank flowing along
+// out-edges in proportion to their weights. A random surfer follows an out-edge chosen by weight with
+// probability damping and jumps to a uniformly chosen node otherwise.
+//
+// Parameters:
+//   - g: The graph to compute the ranks for. Undirected edges are followed in both directions.
+//   - damping: The probability of following an edge, usually 0.85.
+//   - maxIter: The maximum number of iterations.
+//   - tol: The convergence tolerance on the L1 change between iterations.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the ranks, summing to 1.
+//
+// Notes:
+//   - Weights are read from ToMatrix, so of parallel edges only the lightest counts. A self-loop keeps
+//     its share of the rank at its node.
+//   - Dangling nodes, which have no out-edges or only zero-weight ones, spread their rank uniformly over all nodes.
+//   - On an unweighted graph every out-edge has weight 1 and the result is the classic PageRank.
+func (u *Unit) WeightedPageRank(g *graph.Graph, damping float64, maxIter int, tol float64) map[graph.Identifier]float64 {
+	matrix := g.ToMatrix()
+	ids := g.NodeIDs()
+	n := float64(len(ids))
+
+	result := make(map[graph.Identifier]float64, len(ids))
+	if len(ids) == 0 {
+		return result
+	}
+
+	// Weighted out-degrees normalize each node's outgoing distribution.
+	out := make([]float64, len(matrix))
+	for _, i := range ids {
+		for _, j := range ids {
+			if matrix[i][j] != graph.INF {
+				out[i] += float64(matrix[i][j].Int())
+			}
+		}
+	}
+
+	// Initialize the ranks with 1/n
+	rank := make([]float64, len(matrix))
+	for _, id := range ids {
+		rank[id] = 1 / n
+	}
+
+	for iter := 0; iter < maxIter; iter++ {
+		// Dangling rank is redistributed uniformly along with the teleportation.
+		dangling := 0.0
+		for _, id := range ids {
+			if out[id] == 0 {
+				dangling += rank[id]
+			}
+		}
+
+		base := (1-damping)/n + damping*dangling/n
+
+		newRank := make([]float64, len(matrix))
+		for _, id := range ids {
+			newRank[id] = base
+		}
+
+		for _, i := range ids {
+			if out[i] == 0 {
+				continue
+			}
+
+			for _, j := range ids {
+				if matrix[i][j] != graph.INF {
+					newRank[j] += damping * rank[i] * float64(matrix[i][j].Int()) / out[i]
+				}
+			}
+		}
+
+		// Check for convergence
+		diff := l1Distance(newRank, rank)
+
+		rank = newRank
+
+		if diff < tol {
+			break
+		}
+	}
+
+	for _, id := range ids {
+		result[id] = rank[id]
+	}
+
+	return result
+}
@@ -0,0 +1,274 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// KernighanLin refines a bipartition of the graph with the Kernighan-Lin heuristic.
+// Each pass tentatively swaps node pairs between the halves, always picking the pair with the largest gain
+// in cut weight, and then keeps the prefix of swaps with the largest total gain. Passes repeat until
+// no prefix improves the cut or maxPasses is reached.
+//
+// Parameters:
+//   - g: The graph to partition. Unweighted edges count as weight 1.
+//   - partA: The nodes of the first half of the initial partition.
+//   - partB: The nodes of the second half of the initial partition.
+//   - maxPasses: The maximum number of passes.
+//
+// Returns:
+//   - The refined first half, in ascending order.
+//   - The refined second half, in ascending order.
+//
+// Notes:
+//   - Swaps preserve the sizes of the halves, so a balanced initial partition (e.g. from SpectralBisection) stays balanced.
+//   - The cut weight never increases. Missing and duplicate identifiers are ignored, as are edges to nodes in neither half.
+//   - For directed graphs the weights of both directions between two nodes are summed.
+//   - Each pass costs O(n³) over the n nodes of the partition.
+func KernighanLin(g *graph.Graph, partA, partB []graph.Identifier, maxPasses int) ([]graph.Identifier, []graph.Identifier) {
+	ids := []graph.Identifier{}
+	side := make(map[graph.Identifier]bool) // true for the first half
+	index := make(map[graph.Identifier]int)
+
+	for half, part := range [][]graph.Identifier{partA, partB} {
+		for _, id := range part {
+			if _, seen := index[id]; seen {
+				continue
+			}
+
+			if _, e := g.FindNode(id); e != nil {
+				continue
+			}
+
+			index[id] = len(ids)
+			side[id] = half == 0
+			ids = append(ids, id)
+		}
+	}
+
+	n := len(ids)
+	weight := make([][]int64, n)
+	for i := range weight {
+		weight[i] = make([]int64, n)
+	}
+
+	for i, id := range ids {
+		for _, e := range g.NeighborEdges(id) {
+			j, ok := index[e.To()]
+			if !ok || i == j {
+				continue
+			}
+
+			weight[i][j] += int64(e.Distance())
+
+			if g.Directed() {
+				weight[j][i] += int64(e.Distance())
+			}
+		}
+	}
+
+	inA := make([]bool, n)
+	for i, id := range ids {
+		inA[i] = side[id]
+	}
+
+	for pass := 0; pass < maxPasses; pass++ {
+		if !kernighanLinPass(weight, inA) {
+			break
+		}
+	}
+
+	a, b := []graph.Identifier{}, []graph.Identifier{}
+	for i, id := range ids {
+		if inA[i] {
+			a = append(a, id)
+		} else {
+			b = append(b, id)
+		}
+	}
+
+	sort.Slice(a, func(i, j int) bool { return a[i] < a[j] })
+	sort.Slice(b, func(i, j int) bool { return b[i] < b[j] })
+
+	return a, b
+}
+
+// kernighanLinPass runs one pass of the Kernighan-Lin heuristic and applies the best prefix of swaps.
+//
+// Parameters:
+//   - weight: The symmetric edge weights between positions.
+//   - inA: Whether each position is in the first half; updated in place.
+//
+// Returns:
+//   - true if the pass reduced the cut weight, false otherwise.
+func kernighanLinPass(weight [][]int64, inA []bool) bool {
+	n := len(weight)
+
+	// diff[v] is the external minus the internal edge weight of v, the gain of moving v alone.
+	diff := make([]int64, n)
+	for v := 0; v < n; v++ {
+		for u := 0; u < n; u++ {
+			if inA[u] == inA[v] {
+				diff[v] -= weight[v][u]
+			} else {
+				diff[v] += weight[v][u]
+			}
+		}
+	}
+
+	locked := make([]bool, n)
+	swaps := [][2]int{}
+	gains := []int64{}
+
+	for {
+		bestA, bestB, bestGain := -1, -1, int64(0)
+
+		for a := 0; a < n; a++ {
+			if locked[a] || !inA[a] {
+				continue
+			}
+
+			for b := 0; b < n; b++ {
+				if locked[b] || inA[b] {
+					continue
+				}
+
+				gain := diff[a] + diff[b] - 2*weight[a][b]
+				if bestA == -1 || gain > bestGain {
+					bestA, bestB, bestGain = a, b, gain
+				}
+			}
+		}
+
+		if bestA == -1 {
+			break
+		}
+
+		locked[bestA], locked[bestB] = true, true
+		swaps = append(swaps, [2]int{bestA, bestB})
+		gains = append(gains, bestGain)
+
+		// Update the gains of the unlocked nodes as if the pair had been swapped.
+		for v := 0; v < n; v++ {
+			if locked[v] {
+				continue
+			}
+
+			if inA[v] {
+				diff[v] += 2*weight[v][bestA] - 2*weight[v][bestB]
+			} else {
+				diff[v] += 2*weight[v][bestB] - 2*weight[v][bestA]
+			}
+		}
+	}
+
+	// Keep the prefix of swaps with the largest cumulative gain.
+	best, bestK, total := int64(0), 0, int64(0)
+	for k, gain := range gains {
+		total += gain
+		if total > best {
+			best, bestK = total, k+1
+		}
+	}
+
+	for _, swap := range swaps[:bestK] {
+		inA[swap[0]], inA[swap[1]] = false, true
+	}
+
+	return bestK > 0
+}
+
+// CutSize computes the weight of the cut between two sets of nodes, e.g. the halves of a partition found by
+// SpectralBisection, KernighanLin, or a community detection algorithm.
+//
+// Parameters:
+//   - g: The partitioned graph. Unweighted edges count as weight 1.
+//   - partA: The nodes of the first set.
+//   - partB: The nodes of the second set.
+//
+// Returns:
+//   - The total weight of the edges with one end in partA and the other in partB.
+//
+// Notes:
+//   - For directed graphs, edges in both directions between the sets count.
+//   - Missing and duplicate identifiers are ignored; a node listed in both sets belongs to partA.
+func CutSize(g *graph.Graph, partA, partB []graph.Identifier) int64 {
+	inA := make(map[graph.Identifier]bool, len(partA))
+	for _, id := range partA {
+		inA[id] = true
+	}
+
+	inB := make(map[graph.Identifier]bool, len(partB))
+	for _, id := range partB {
+		if !inA[id] {
+			inB[id] = true
+		}
+	}
+
+	var cut int64
+
+	for _, id := range sortedIDs(g) {
+		for _, e := range g.NeighborEdges(id) {
+			// Undirected edges are stored at both ends, so they are counted from the first set only.
+			if (inA[id] && inB[e.To()]) || (g.Directed() && inB[id] && inA[e.To()]) {
+				cut += int64(e.Distance())
+			}
+		}
+	}
+
+	return cut
+}
+
+// Conductance measures how well a set of nodes is separated from the rest of the graph: the weight of the edges
+// leaving the set divided by the smaller of the volumes of the set and its complement. Well-separated
+// communities have a conductance close to 0.
+//
+// Parameters:
+//   - g: The partitioned graph. Unweighted edges count as weight 1.
+//   - part: The nodes of the set; all other nodes form the complement.
+//
+// Returns:
+//   - The conductance, between 0 and 1, or 0 if the set or its complement has volume 0.
+//
+// Notes:
+//   - The volume of a set is the sum of the weighted degrees of its nodes. Directed edges count toward the
+//     degrees of both ends, and a self-loop counts twice toward the degree of its node.
+//   - The cut is measured as by CutSize, so both directions count for directed graphs.
+func Conductance(g *graph.Graph, part []graph.Identifier) float64 {
+	inside := make(map[graph.Identifier]bool, len(part))
+	for _, id := range part {
+		inside[id] = true
+	}
+
+	degree := make(map[graph.Identifier]int64, g.NodeCount())
+
+	for _, id := range sortedIDs(g) {
+		for _, e := range g.NeighborEdges(id) {
+			degree[id] += int64(e.Distance())
+
+			// Directed edges are stored at their source only, undirected self-loops once.
+			if g.Directed() || e.To() == id {
+				degree[e.To()] += int64(e.Distance())
+			}
+		}
+	}
+
+	complement := []graph.Identifier{}
+	var volume, complementVolume int64
+
+	for _, id := range sortedIDs(g) {
+		if inside[id] {
+			volume += degree[id]
+		} else {
+			complement = append(complement, id)
+			complementVolume += degree[id]
+		}
+	}
+
+	if min(volume, complementVolume) == 0 {
+		return 0
+	}
+
+	return float64(CutSize(g, part, complement)) / float64(min(volume, complementVolume))
+}
@@ -24,8 +24,8 @@ func (u *Unit) AverageShortestPathLength(g *graph.Graph) float64 {
 	var pairCount int
 
 	// Sum up distances for all shortest paths.
-	for _, path := range u.shortestPaths {
-		totalDistance += path.Distance()
+	for _, distance := range u.sortedDistances() {
+		totalDistance += distance
 		pairCount++
 	}
 
@@ -48,8 +48,8 @@ func (pu *ParallelUnit) AverageShortestPathLength(g *graph.Graph) float64 {
 	var pairCount int
 
 	// Sum up distances for all shortest paths.
-	for _, path := range pu.shortestPaths {
-		totalDistance += path.Distance()
+	for _, distance := range pu.sortedDistances() {
+		totalDistance += distance
 		pairCount++
 	}
 
@@ -78,17 +78,19 @@ func (u *Unit) PercentileShortestPathLength(g *graph.Graph, percentile float64)
 		u.computePaths(g)
 	}
 
+	distances := u.sortedDistances()
+
 	// Calculate the index for the desired percentile.
-	index := int(percentile * float64(len(u.shortestPaths)))
+	index := int(percentile * float64(len(distances)))
 
 	// Clamp the index to the valid range.
-	if index >= len(u.shortestPaths) {
-		index = len(u.shortestPaths) - 1
+	if index >= len(distances) {
+		index = len(distances) - 1
 	} else if index < 0 {
 		index = 0
 	}
 
-	return u.shortestPaths[index].Distance()
+	return distances[index]
 }
 
 // ParallelUnit version of PercentileShortestPathLength.
@@ -99,15 +101,17 @@ func (pu *ParallelUnit) PercentileShortestPathLength(g *graph.Graph, percentile
 		pu.computePaths(g)
 	}
 
+	distances := pu.sortedDistances()
+
 	// Calculate the index for the desired percentile.
-	index := int(percentile * float64(len(pu.shortestPaths)))
+	index := int(percentile * float64(len(distances)))
 
 	// Clamp the index to the valid range.
-	if index >= len(pu.shortestPaths) {
-		index = len(pu.shortestPaths) - 1
+	if index >= len(distances) {
+		index = len(distances) - 1
 	} else if index < 0 {
 		index = 0
 	}
 
-	return pu.shortestPaths[index].Distance()
+	return distances[index]
 }
@@ -0,0 +1,244 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// SetCompactPaths selects how a Unit caches its shortest paths.
+// By default every shortest path is stored as an explicit graph.Path, which costs O(n²·path length) memory.
+// A compact Unit stores one predecessor tree per source node instead, O(n²) small integers, and
+// reconstructs each path only while a metric visits it. Results are identical in both modes.
+//
+// Parameters:
+//   - compact: true to store predecessor trees, false to store explicit paths.
+//
+// Notes:
+//   - Changing the mode invalidates the cache, so the next metric call recomputes the paths.
+//   - UpdateEdge and RemoveEdge cannot repair a compact cache incrementally; they invalidate it instead.
+func (u *Unit) SetCompactPaths(compact bool) {
+	if u.compact != compact {
+		u.compact = compact
+		u.resetPaths()
+	}
+}
+
+// Precompute eagerly builds the shortest path cache of a Unit, so that a batch of metric calls on the same graph
+// shares a single path computation.
+//
+// Parameters:
+//   - g: The graph to compute the shortest paths for.
+//
+// Notes:
+//   - The paths are only computed if the cache is stale, i.e. if IsComputed is false or g was modified since
+//     the last computation. Afterwards, metrics that read the cache (closeness, harmonic centrality, eccentricity,
+//     diameter, average shortest path length, efficiency) never recompute it until g is modified.
+//   - Node and edge betweenness centrality run their own searches and neither need nor use the cache.
+//   - Like every metric that reads the cache, it panics if g has negative edge weights; check
+//     g.HasNegativeWeights first and use BellmanFord or Johnson for such graphs.
+func (u *Unit) Precompute(g *graph.Graph) {
+	if !g.Updated() || !u.updated {
+		u.computePaths(g)
+	}
+}
+
+// ParallelUnit version of Precompute.
+// Computes the shortest path cache using parallel computations.
+func (pu *ParallelUnit) Precompute(g *graph.Graph) {
+	if !g.Updated() || !pu.updated {
+		pu.computePaths(g)
+	}
+}
+
+// IsComputed reports whether the Unit holds a valid shortest path cache, e.g. after Precompute.
+// The cache is invalidated by SetCompactPaths and by an interrupted computation; modifying the graph makes the
+// next metric call recompute the paths even if IsComputed is true.
+func (u *Unit) IsComputed() bool {
+	return u.updated
+}
+
+// pathForest stores the shortest path tree of every source node in dense slices indexed by node position.
+type pathForest struct {
+	ids   []graph.Identifier       // Node identifiers in ascending order; positions index the rows and columns.
+	index map[graph.Identifier]int // Position of each node identifier.
+	dist  [][]graph.Distance       // dist[s][t] is the distance from position s to position t, INF if unreachable.
+	prev  [][]int32                // prev[s][t] is the position of the predecessor of t on the path from s, -1 if none.
+}
+
+// newPathForest allocates an empty forest for the nodes of g.
+func newPathForest(g *graph.Graph) *pathForest {
+	ids := sortedIDs(g)
+	forest := &pathForest{
+		ids:   ids,
+		index: make(map[graph.Identifier]int, len(ids)),
+		dist:  make([][]graph.Distance, len(ids)),
+		prev:  make([][]int32, len(ids)),
+	}
+
+	for i, id := range ids {
+		forest.index[id] = i
+	}
+
+	return forest
+}
+
+// setTree stores the result of the search from the node at position s.
+func (f *pathForest) setTree(s int, tree searchTree) {
+	dist := make([]graph.Distance, len(f.ids))
+	prev := make([]int32, len(f.ids))
+
+	for t, id := range f.ids {
+		dist[t], prev[t] = graph.INF, -1
+
+		if d, ok := tree.dist[id]; ok {
+			dist[t] = d
+		}
+		if p, ok := tree.prev[id]; ok {
+			prev[t] = int32(f.index[p])
+		}
+	}
+
+	f.dist[s], f.prev[s] = dist, prev
+}
+
+// path reconstructs the shortest path from position s to position t, which must be reachable.
+func (f *pathForest) path(s, t int) graph.Path {
+	nodes := []graph.Identifier{f.ids[t]}
+
+	for at := t; at != s; {
+		at = int(f.prev[s][at])
+		nodes = append(nodes, f.ids[at])
+	}
+
+	for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+		nodes[i], nodes[j] = nodes[j], nodes[i]
+	}
+
+	return *graph.NewPath(f.dist[s][t], nodes)
+}
+
+// resetPaths discards the cached paths and marks the cache invalid.
+func (u *Unit) resetPaths() {
+	u.shortestPaths = []graph.Path{}
+	u.trees = nil
+	u.updated = false
+	u.loaded = false
+}
+
+// storePaths caches a computed forest, expanding it into explicit sorted paths unless the Unit is compact.
+func (u *Unit) storePaths(forest *pathForest) {
+	if u.compact {
+		u.trees = forest
+		return
+	}
+
+	for s := range forest.ids {
+		for t := range forest.ids {
+			if s != t && forest.dist[s][t] != graph.INF {
+				u.shortestPaths = append(u.shortestPaths, forest.path(s, t))
+			}
+		}
+	}
+
+	// Sort the paths by their total distance.
+	sort.Slice(u.shortestPaths, func(i, j int) bool {
+		return lessPath(u.shortestPaths[i], u.shortestPaths[j])
+	})
+}
+
+// pathSlots returns the number of slots forEachPathIn iterates over.
+// Explicit paths occupy one slot each; a compact cache has one slot per ordered node pair, some of them empty.
+func (u *Unit) pathSlots() int {
+	if u.trees != nil {
+		return len(u.trees.ids) * len(u.trees.ids)
+	}
+
+	return len(u.shortestPaths)
+}
+
+// forEachPathIn calls fn for every cached path stored in the slots [start, end).
+// Explicit paths are visited in sorted order; compact paths are reconstructed one at a time by source and target.
+func (u *Unit) forEachPathIn(start, end int, fn func(path graph.Path)) {
+	if u.trees == nil {
+		for _, path := range u.shortestPaths[start:end] {
+			fn(path)
+		}
+
+		return
+	}
+
+	n := len(u.trees.ids)
+
+	for slot := start; slot < end; slot++ {
+		s, t := slot/n, slot%n
+
+		if s != t && u.trees.dist[s][t] != graph.INF {
+			fn(u.trees.path(s, t))
+		}
+	}
+}
+
+// forEachPath calls fn for every cached path.
+func (u *Unit) forEachPath(fn func(path graph.Path)) {
+	u.forEachPathIn(0, u.pathSlots(), fn)
+}
+
+// sortedDistances returns the distances of all cached paths in ascending order,
+// matching the order of the explicit paths so that sums over them are bit-identical in both modes.
+func (u *Unit) sortedDistances() []graph.Distance {
+	if u.trees == nil {
+		distances := make([]graph.Distance, len(u.shortestPaths))
+		for i, path := range u.shortestPaths {
+			distances[i] = path.Distance()
+		}
+
+		return distances
+	}
+
+	distances := []graph.Distance{}
+	for s, row := range u.trees.dist {
+		for t, d := range row {
+			if s != t && d != graph.INF {
+				distances = append(distances, d)
+			}
+		}
+	}
+
+	sort.Slice(distances, func(i, j int) bool { return distances[i] < distances[j] })
+
+	return distances
+}
+
+// longestPath returns the last cached path in the order of lessPath, i.e. the diameter.
+// Without any path between distinct nodes, e.g. on an empty or single-node graph, it returns
+// an empty path of distance INF in both modes.
+func (u *Unit) longestPath() graph.Path {
+	if u.trees == nil {
+		if len(u.shortestPaths) == 0 {
+			return *graph.NewPath(graph.INF, []graph.Identifier{})
+		}
+
+		return u.shortestPaths[len(u.shortestPaths)-1]
+	}
+
+	// Positions follow identifier order, so comparing (distance, s, t) matches lessPath.
+	bestS, bestT := -1, -1
+	for s, row := range u.trees.dist {
+		for t, d := range row {
+			if s == t || d == graph.INF {
+				continue
+			}
+
+			if bestS == -1 || d >= u.trees.dist[bestS][bestT] {
+				bestS, bestT = s, t
+			}
+		}
+	}
+
+	if bestS == -1 {
+		return *graph.NewPath(graph.INF, []graph.Identifier{})
+	}
+
+	return u.trees.path(bestS, bestT)
+}
@@ -0,0 +1,80 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// NodePercolation removes nodes one at a time and tracks how the graph fragments.
+// After each removal it records the size of the largest connected component of the remaining graph,
+// which shows how quickly the network breaks apart under a targeted or random attack.
+//
+// Parameters:
+//   - g: The graph to attack. It is not modified.
+//   - order: The identifiers of the nodes to remove, in order. Missing and duplicate identifiers are skipped.
+//     If nil, the nodes are removed by descending degree, ties broken toward smaller identifiers.
+//
+// Returns:
+//   - The size of the largest remaining component after each removal, one entry per removed node.
+//     The last entry is 0 if every node has been removed.
+//
+// Notes:
+//   - Components are weakly connected for directed graphs, so edge direction is ignored.
+//   - The default order uses the degrees of the original graph and is not recomputed after each removal.
+//   - Every step recomputes the components of the induced subgraph, so it costs O(k(n + m)) for k removals.
+func NodePercolation(g *graph.Graph, order []graph.Identifier) []int {
+	if order == nil {
+		order = degreeOrder(g)
+	}
+
+	remaining := make(map[graph.Identifier]bool, g.NodeCount())
+	for _, id := range g.NodeIDs() {
+		remaining[id] = true
+	}
+
+	sizes := make([]int, 0, len(order))
+
+	for _, removed := range order {
+		if !remaining[removed] {
+			continue
+		}
+
+		delete(remaining, removed)
+
+		ids := make([]graph.Identifier, 0, len(remaining))
+		for id := range remaining {
+			ids = append(ids, id)
+		}
+
+		subgraph, _ := g.Subgraph(ids)
+
+		largest := 0
+		for _, component := range WeaklyConnectedComponents(subgraph) {
+			largest = max(largest, len(component))
+		}
+
+		sizes = append(sizes, largest)
+	}
+
+	return sizes
+}
+
+// degreeOrder returns the nodes sorted by descending total degree, ties broken toward smaller identifiers.
+func degreeOrder(g *graph.Graph) []graph.Identifier {
+	ids := sortedIDs(g)
+	degree := make(map[graph.Identifier]int, len(ids))
+
+	for _, id := range ids {
+		for _, to := range g.Neighbors(id) {
+			degree[id]++
+			if g.Directed() {
+				degree[to]++
+			}
+		}
+	}
+
+	sort.SliceStable(ids, func(i, j int) bool { return degree[ids[i]] > degree[ids[j]] })
+
+	return ids
+}
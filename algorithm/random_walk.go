@@ -0,0 +1,94 @@
+package algorithm
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// BiasedRandomWalk performs a second-order random walk as used by node2vec to sample node neighborhoods.
+// Having moved from node t to node v, the walk steps to a neighbor x of v with a probability proportional to
+// 1/p if x is t, 1 if x is also a neighbor of t, and 1/q otherwise.
+//
+// Parameters:
+//   - g: The graph to walk on. Edge weights are ignored.
+//   - start: The identifier of the first node of the walk.
+//   - length: The maximum number of nodes of the walk, including the start.
+//   - p: The return parameter. A high p makes stepping back to the previous node unlikely.
+//   - q: The in-out parameter. A low q favors moving away from the previous node, like a depth-first search;
+//     a high q keeps the walk near it, like a breadth-first search.
+//   - seed: The seed of the random choices, making the walk reproducible.
+//
+// Returns:
+//   - The nodes of the walk in order, starting at start. The walk ends early at a node without outgoing edges.
+//     Empty if the start does not exist or length is less than 1.
+//
+// Notes:
+//   - The first step has no previous node and is chosen uniformly. p = q = 1 gives an unbiased random walk.
+func BiasedRandomWalk(g *graph.Graph, start graph.Identifier, length int, p, q float64, seed int64) []graph.Identifier {
+	walk := []graph.Identifier{}
+
+	if _, e := g.FindNode(start); e != nil || length < 1 {
+		return walk
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	walk = append(walk, start)
+
+	for len(walk) < length {
+		current := walk[len(walk)-1]
+		neighbors := g.Neighbors(current)
+
+		if len(neighbors) == 0 {
+			break
+		}
+
+		// Sort the candidates so that the walk depends on the seed only.
+		sort.Slice(neighbors, func(i, j int) bool { return neighbors[i] < neighbors[j] })
+
+		if len(walk) == 1 {
+			walk = append(walk, neighbors[r.Intn(len(neighbors))])
+			continue
+		}
+
+		previous := walk[len(walk)-2]
+		near := make(map[graph.Identifier]bool)
+		for _, id := range g.Neighbors(previous) {
+			near[id] = true
+		}
+
+		weights := make([]float64, len(neighbors))
+		total := 0.0
+
+		for i, next := range neighbors {
+			switch {
+			case next == previous:
+				weights[i] = 1 / p
+			case near[next]:
+				weights[i] = 1
+			default:
+				weights[i] = 1 / q
+			}
+
+			total += weights[i]
+		}
+
+		// Pick a neighbor with probability proportional to its weight.
+		threshold := r.Float64() * total
+		chosen := neighbors[len(neighbors)-1]
+
+		for i, next := range neighbors {
+			if threshold < weights[i] {
+				chosen = next
+				break
+			}
+
+			threshold -= weights[i]
+		}
+
+		walk = append(walk, chosen)
+	}
+
+	return walk
+}
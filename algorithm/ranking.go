@@ -0,0 +1,47 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// NodeScore pairs a node with its score, as returned by TopK.
+type NodeScore struct {
+	Node  graph.Identifier // The node identifier.
+	Score float64          // The score of the node.
+}
+
+// TopK returns the k highest-scoring nodes of a score map, such as the result of a centrality function.
+//
+// Parameters:
+//   - scores: A map where the keys are node identifiers and the values are their scores.
+//   - k: The number of nodes to return. If k exceeds the number of nodes, every node is returned.
+//
+// Returns:
+//   - A slice of at most k node scores in descending order of score.
+//     Ties are broken by ascending identifier, so the order is deterministic.
+func TopK(scores map[graph.Identifier]float64, k int) []NodeScore {
+	result := make([]NodeScore, 0, len(scores))
+
+	for node, score := range scores {
+		result = append(result, NodeScore{Node: node, Score: score})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+
+		return result[i].Node < result[j].Node
+	})
+
+	if k < 0 {
+		k = 0
+	}
+	if k < len(result) {
+		result = result[:k]
+	}
+
+	return result
+}
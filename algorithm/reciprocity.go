@@ -0,0 +1,42 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// Reciprocity computes the fraction of directed edges that have a reciprocal edge in the opposite direction.
+// An edge (i, j) is reciprocated when the edge (j, i) also exists.
+//
+// Parameters:
+//   - g: The graph to compute the reciprocity for.
+//
+// Returns:
+//   - The reciprocity in [0, 1]; 0 if the graph has no edges.
+//
+// Notes:
+//   - Every edge of an undirected graph is stored in both directions, so undirected graphs with edges score 1.0.
+func Reciprocity(g *graph.Graph) float64 {
+	matrix := g.ToMatrix()
+
+	edges, reciprocated := 0, 0
+
+	for i := range matrix {
+		for j := range matrix[i] {
+			if i == j || matrix[i][j] == graph.INF {
+				continue
+			}
+
+			edges++
+
+			if matrix[j][i] != graph.INF {
+				reciprocated++
+			}
+		}
+	}
+
+	if edges == 0 {
+		return 0.0
+	}
+
+	return float64(reciprocated) / float64(edges)
+}
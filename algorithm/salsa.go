@@ -0,0 +1,141 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// SALSA computes the hub and authority scores of each node in the graph for a Unit with the
+// Stochastic Approach for Link-Structure Analysis.
+// Like HITS it separates hubs and authorities, but scores are the stationary distributions of two random walks
+// on the bipartite hub/authority graph: the authority walk steps back along an in-link and forward along an
+// out-link, each chosen with probability proportional to its weight. Tightly knit communities therefore do not
+// absorb all the score as they do under HITS.
+//
+// Parameters:
+//   - g: The graph to compute the scores for. Edge weights scale the transition probabilities.
+//   - maxIter: The maximum number of iterations.
+//   - tol: The convergence tolerance on the summed L1 change of both score vectors.
+//
+// Returns:
+//   - hubs: A map where the keys are node identifiers and the values are the hub scores, summing to 1.
+//   - authorities: A map where the keys are node identifiers and the values are the authority scores, summing to 1.
+//
+// Notes:
+//   - Nodes without out-links have a zero hub score and nodes without in-links a zero authority score.
+//   - Within a connected part of the bipartite graph, authority scores are proportional to the weighted in-degree
+//     and hub scores to the weighted out-degree; each part is weighted by its share of the authorities (or hubs).
+func (u *Unit) SALSA(g *graph.Graph, maxIter int, tol float64) (hubs, authorities map[graph.Identifier]float64) {
+	matrix := g.ToMatrix()
+	n := len(matrix)
+
+	// Weighted out-degrees and in-degrees normalize the transition probabilities.
+	out := make([]float64, n)
+	in := make([]float64, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j && matrix[i][j] != graph.INF {
+				out[i] += float64(matrix[i][j].Int())
+				in[j] += float64(matrix[i][j].Int())
+			}
+		}
+	}
+
+	// Initialize both score vectors with 1/n
+	hub := make([]float64, n)
+	auth := make([]float64, n)
+	for i := 0; i < n; i++ {
+		hub[i] = 1.0 / float64(n)
+		auth[i] = 1.0 / float64(n)
+	}
+
+	for iter := 0; iter < maxIter; iter++ {
+		newAuth := salsaStep(matrix, auth, in, out, false)
+		newHub := salsaStep(matrix, hub, out, in, true)
+
+		normalizeL1(newAuth)
+		normalizeL1(newHub)
+
+		// Check for convergence
+		diff := l1Distance(newAuth, auth) + l1Distance(newHub, hub)
+
+		auth, hub = newAuth, newHub
+
+		if diff < tol {
+			break
+		}
+	}
+
+	return toScoreMap(hub), toScoreMap(auth)
+}
+
+// salsaStep advances one of the two SALSA random walks by a full two-step move.
+//
+// Parameters:
+//   - matrix: The adjacency matrix of the graph.
+//   - scores: The current distribution of the walk.
+//   - first: The weighted degree used by the first step: in-degrees for the authority walk, out-degrees for the hub walk.
+//   - second: The weighted degree used by the second step.
+//   - forward: false for the authority walk (back along an in-link, then forward), true for the hub walk.
+//
+// Returns:
+//   - The distribution after the move.
+func salsaStep(matrix graph.Matrix, scores, first, second []float64, forward bool) []float64 {
+	n := len(matrix)
+
+	// link returns the weight of the edge between a node x on the walk's side and a node y on the other side.
+	// The authority walk sits on authorities, so the edge runs y -> x; the hub walk sits on hubs, so it runs x -> y.
+	link := func(x, y int) float64 {
+		from, to := y, x
+		if forward {
+			from, to = x, y
+		}
+
+		if from == to || matrix[from][to] == graph.INF {
+			return 0
+		}
+
+		return float64(matrix[from][to].Int())
+	}
+
+	// Step to the other side of the bipartite graph.
+	middle := make([]float64, n)
+	for j := 0; j < n; j++ {
+		if first[j] == 0 || scores[j] == 0 {
+			continue
+		}
+
+		for i := 0; i < n; i++ {
+			middle[i] += link(j, i) / first[j] * scores[j]
+		}
+	}
+
+	// Step back to the original side.
+	result := make([]float64, n)
+	for i := 0; i < n; i++ {
+		if second[i] == 0 || middle[i] == 0 {
+			continue
+		}
+
+		for k := 0; k < n; k++ {
+			result[k] += link(k, i) / second[i] * middle[i]
+		}
+	}
+
+	return result
+}
+
+// normalizeL1 scales the non-negative vector to sum to 1 in place. A zero vector is left unchanged.
+func normalizeL1(vector []float64) {
+	sum := 0.0
+	for _, value := range vector {
+		sum += value
+	}
+
+	if sum == 0 {
+		return
+	}
+
+	for i := range vector {
+		vector[i] /= sum
+	}
+}
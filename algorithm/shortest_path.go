@@ -1,9 +1,13 @@
 package algorithm
 
 import (
+	"container/heap"
+	"context"
+	"fmt"
 	"sort"
 	"sync"
 
+	err "github.com/elecbug/go-graphtric/err" // Custom error package
 	"github.com/elecbug/go-graphtric/graph"
 )
 
@@ -19,240 +23,642 @@ import (
 //   - If no path exists, the returned Path has distance INF and an empty node sequence.
 func ShortestPath(g *graph.Graph, start, end graph.Identifier) *graph.Path {
 	if g.Type() == graph.DirectedWeighted || g.Type() == graph.UndirectedWeighted {
-		return weightedShortestPath(g.ToMatrix(), start, end)
+		return weightedShortestPath(g, start, end)
 	} else if g.Type() == graph.DirectedUnweighted || g.Type() == graph.UndirectedUnweighted {
-		return unweightedShortestPath(g.ToMatrix(), start, end)
+		return unweightedShortestPath(g, start, end)
 	} else {
 		return graph.NewPath(graph.INF, []graph.Identifier{})
 	}
 }
 
-// computePaths calculates all shortest paths between every pair of nodes in the graph for a Unit.
-// After computation, the `shortestPaths` field in the Unit is updated and sorted by path distance in ascending order.
+// HopDistances computes the number of edges (hops) on the shortest route from a source to every node.
+// Edge weights are ignored and a plain BFS is used, which is faster than Dijkstra when weights don't matter.
 //
 // Parameters:
 //   - g: The graph to perform the computation on.
-func (u *Unit) computePaths(g *graph.Graph) {
-	u.shortestPaths = []graph.Path{}
-	n := len(g.ToMatrix())
+//   - source: The source node identifier.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are hop counts from the source.
+//     Unreachable nodes map to -1; the map is empty if the source does not exist.
+func (u *Unit) HopDistances(g *graph.Graph, source graph.Identifier) map[graph.Identifier]int {
+	result := make(map[graph.Identifier]int, g.NodeCount())
 
-	for start := graph.Identifier(0); start < graph.Identifier(n); start++ {
-		for end := graph.Identifier(0); end < graph.Identifier(n); end++ {
-			if start == end {
-				continue
+	if _, err := g.FindNode(source); err != nil {
+		return result
+	}
+
+	for _, id := range g.NodeIDs() {
+		result[id] = -1
+	}
+
+	result[source] = 0
+	queue := []graph.Identifier{source}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, next := range g.Neighbors(node) {
+			if result[next] == -1 {
+				result[next] = result[node] + 1
+				queue = append(queue, next)
 			}
+		}
+	}
 
-			path := ShortestPath(g, start, end)
+	return result
+}
 
-			if path.Distance() != graph.INF {
-				u.shortestPaths = append(u.shortestPaths, *path)
+// MultiSourceBFS computes the hop distance from every node to its nearest source, as used in facility location.
+// The BFS queue is seeded with all sources at distance 0 at once, so a single search partitions the graph
+// into Voronoi-like regions around the sources.
+//
+// Parameters:
+//   - g: The graph to perform the computation on. Edge weights are ignored.
+//   - sources: The identifiers of the source nodes. Missing and duplicate identifiers are ignored.
+//
+// Returns:
+//   - A map from every node to its hop count from the nearest source; unreachable nodes map to -1.
+//   - A map from every reachable node to its nearest source. A node equally close to several sources
+//     is assigned to the one with the smallest identifier.
+func MultiSourceBFS(g *graph.Graph, sources []graph.Identifier) (map[graph.Identifier]int, map[graph.Identifier]graph.Identifier) {
+	distance := make(map[graph.Identifier]int, g.NodeCount())
+	nearest := make(map[graph.Identifier]graph.Identifier, g.NodeCount())
+
+	for _, id := range g.NodeIDs() {
+		distance[id] = -1
+	}
+
+	queue := []graph.Identifier{}
+
+	for _, source := range sources {
+		if d, exists := distance[source]; exists && d == -1 {
+			distance[source] = 0
+			nearest[source] = source
+			queue = append(queue, source)
+		}
+	}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, next := range g.Neighbors(node) {
+			switch distance[next] {
+			case -1:
+				distance[next] = distance[node] + 1
+				nearest[next] = nearest[node]
+				queue = append(queue, next)
+			case distance[node] + 1:
+				// The whole previous layer is dequeued before next, so ties can still be broken here.
+				nearest[next] = min(nearest[next], nearest[node])
+			}
+		}
+	}
+
+	return distance, nearest
+}
+
+// AllShortestPaths enumerates every shortest path between two nodes, whereas ShortestPath returns only one of them.
+// The paths are reconstructed from the predecessor sets of a single-source search from the start node.
+//
+// Parameters:
+//   - g: The graph to perform the computation on.
+//   - from: The starting node identifier.
+//   - to: The ending node identifier.
+//
+// Returns:
+//   - Every path of minimum total distance from start to end, ordered lexicographically by their node sequences.
+//     Empty if either node does not exist or the end is unreachable. If both are the same node,
+//     the single path consists of that node with distance 0.
+//
+// Notes:
+//   - The number of shortest paths can grow exponentially with the size of the graph, e.g. on a chain of diamonds.
+func (u *Unit) AllShortestPaths(g *graph.Graph, from, to graph.Identifier) []graph.Path {
+	result := []graph.Path{}
+
+	if _, err := g.FindNode(from); err != nil {
+		return result
+	}
+
+	state := brandesSource(g, from)
+
+	distance, reached := state.dist[to]
+	if !reached {
+		return result
+	}
+
+	// Walk the predecessor sets back from the end, building each path in reverse.
+	reversed := []graph.Identifier{to}
+
+	var walk func(node graph.Identifier)
+	walk = func(node graph.Identifier) {
+		if node == from {
+			nodes := make([]graph.Identifier, len(reversed))
+			for i, id := range reversed {
+				nodes[len(reversed)-1-i] = id
 			}
+
+			result = append(result, *graph.NewPath(distance, nodes))
+			return
+		}
+
+		for _, prev := range state.pred[node] {
+			reversed = append(reversed, prev)
+			walk(prev)
+			reversed = reversed[:len(reversed)-1]
 		}
 	}
 
-	// Sort the paths by their total distance.
-	sort.Slice(u.shortestPaths, func(i, j int) bool {
-		return u.shortestPaths[i].Distance() < u.shortestPaths[j].Distance()
+	walk(to)
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i].Nodes(), result[j].Nodes()
+		for k := 0; k < len(a) && k < len(b); k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+
+		return len(a) < len(b)
 	})
 
-	g.Update()
-	u.updated = true
+	return result
 }
 
-// computePaths calculates all shortest paths in parallel for a ParallelUnit.
-// After computation, the `shortestPaths` field in the ParallelUnit is updated and sorted by path distance in ascending order.
+// ShortestPathFunc computes the shortest path between two nodes under a custom edge cost, e.g. one that
+// depends on the time of day or adds a penalty for entering certain nodes. It runs Dijkstra's algorithm
+// with the cost function applied to every traversed edge.
 //
 // Parameters:
 //   - g: The graph to perform the computation on.
-func (pu *ParallelUnit) computePaths(g *graph.Graph) {
-	pu.shortestPaths = []graph.Path{}
+//   - from: The starting node identifier.
+//   - to: The ending node identifier.
+//   - cost: Returns the effective cost of traversing the edge (from, to), given its stored weight, which is
+//     1 for unweighted graphs. The returned cost must not be negative.
+//
+// Returns:
+//   - The path of minimum total cost, whose distance is that cost.
+//   - true if such a path was found; false, with a path of distance INF and no nodes, if either node does
+//     not exist, the end is unreachable, or cost returned a negative value for an edge the search examined.
+func (u *Unit) ShortestPathFunc(g *graph.Graph, from, to graph.Identifier, cost func(from, to graph.Identifier, baseWeight int64) int64) (graph.Path, bool) {
+	none := *graph.NewPath(graph.INF, []graph.Identifier{})
+
+	if _, err := g.FindNode(from); err != nil {
+		return none, false
+	}
+	if _, err := g.FindNode(to); err != nil {
+		return none, false
+	}
 
-	type to struct {
-		start graph.Identifier
-		end   graph.Identifier
+	tree := searchTree{
+		dist: map[graph.Identifier]graph.Distance{from: 0},
+		prev: make(map[graph.Identifier]graph.Identifier),
 	}
 
-	n := len(g.ToMatrix())
+	visited := make(map[graph.Identifier]bool)
+	queue := &distanceHeap{{node: from, distance: 0}}
 
-	jobChan := make(chan to)
-	resultChan := make(chan graph.Path)
-	workerCount := pu.maxCore
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(distanceItem)
+		node := item.node
 
-	var wg sync.WaitGroup
-	wg.Add(int(workerCount))
+		if visited[node] {
+			continue
+		}
 
-	// Start worker goroutines to compute paths in parallel.
-	for i := uint(0); i < workerCount; i++ {
-		go func() {
-			defer wg.Done()
-			for job := range jobChan {
-				path := ShortestPath(g, job.start, job.end)
+		visited[node] = true
 
-				if path.Distance() != graph.INF {
-					resultChan <- *path
-				}
+		if node == to {
+			return *graph.NewPath(tree.dist[to], tracePath(tree.prev, from, to)), true
+		}
+
+		for _, e := range g.NeighborEdges(node) {
+			next := e.To()
+
+			if visited[next] {
+				continue
 			}
-		}()
+
+			// Dijkstra's algorithm is only correct for non-negative costs.
+			weight := cost(node, next, int64(e.Distance()))
+			if weight < 0 {
+				return none, false
+			}
+
+			alt := tree.dist[node] + graph.Distance(weight)
+			if d, ok := tree.dist[next]; !ok || alt < d {
+				tree.dist[next] = alt
+				tree.prev[next] = node
+				heap.Push(queue, distanceItem{node: next, distance: alt})
+			}
+		}
 	}
 
-	// Generate jobs for every pair of nodes.
-	go func() {
-		for start := 0; start < n; start++ {
-			for end := 0; end < n; end++ {
-				if start != end {
-					jobChan <- to{graph.Identifier(start), graph.Identifier(end)}
-				}
+	return none, false
+}
+
+// WidestPath computes the path between two nodes whose narrowest edge is as wide as possible, treating edge weights
+// as capacities, e.g. the route with the most bandwidth. It runs Dijkstra's algorithm with the bottleneck
+// min(width so far, edge weight) in place of the sum, settling the widest node first.
+//
+// Parameters:
+//   - g: The graph to perform the computation on. Unweighted edges have capacity 1.
+//   - from: The starting node identifier.
+//   - to: The ending node identifier.
+//
+// Returns:
+//   - A maximum-bottleneck path, whose distance is its total weight as for any graph.Path.
+//     When several paths share the maximum bottleneck, one of them is returned.
+//   - The bottleneck of the path, its smallest edge weight; INF64 if from and to are the same node.
+//   - true if a path was found; false, with a path of distance INF and no nodes, if either node does not
+//     exist or the end is unreachable.
+func (u *Unit) WidestPath(g *graph.Graph, from, to graph.Identifier) (graph.Path, int64, bool) {
+	none := *graph.NewPath(graph.INF, []graph.Identifier{})
+
+	if _, err := g.FindNode(from); err != nil {
+		return none, 0, false
+	}
+	if _, err := g.FindNode(to); err != nil {
+		return none, 0, false
+	}
+
+	width := map[graph.Identifier]graph.Distance{from: graph.INF}
+	length := map[graph.Identifier]graph.Distance{from: 0}
+	prev := make(map[graph.Identifier]graph.Identifier)
+
+	// The heap pops the smallest distance, so widths are stored as INF minus the width.
+	visited := make(map[graph.Identifier]bool)
+	queue := &distanceHeap{{node: from, distance: 0}}
+
+	for queue.Len() > 0 {
+		node := heap.Pop(queue).(distanceItem).node
+
+		if visited[node] {
+			continue
+		}
+
+		visited[node] = true
+
+		if node == to {
+			bottleneck := INF64
+			if from != to {
+				bottleneck = int64(width[to])
 			}
+
+			return *graph.NewPath(length[to], tracePath(prev, from, to)), bottleneck, true
 		}
-		close(jobChan)
-	}()
 
-	// Close the result channel after all workers finish.
-	go func() {
-		wg.Wait()
-		close(resultChan)
-	}()
+		for _, e := range g.NeighborEdges(node) {
+			next := e.To()
+
+			if visited[next] {
+				continue
+			}
 
-	// Collect results from workers.
-	for result := range resultChan {
-		pu.shortestPaths = append(pu.shortestPaths, result)
+			alt := min(width[node], e.Distance())
+			if w, seen := width[next]; !seen || alt > w {
+				width[next] = alt
+				length[next] = length[node] + e.Distance()
+				prev[next] = node
+				heap.Push(queue, distanceItem{node: next, distance: graph.INF - alt})
+			}
+		}
 	}
 
-	// Sort the paths by their total distance.
-	sort.Slice(pu.shortestPaths, func(i, j int) bool {
-		return pu.shortestPaths[i].Distance() < pu.shortestPaths[j].Distance()
-	})
+	return none, 0, false
+}
 
-	g.Update()
-	pu.updated = true
+// computePaths calculates all shortest paths between every pair of nodes in the graph for a Unit.
+// After computation, the `shortestPaths` field in the Unit is updated and sorted by path distance in ascending order,
+// or, for a compact Unit, the per-source shortest path trees are stored instead.
+//
+// Parameters:
+//   - g: The graph to perform the computation on.
+//
+// Notes:
+//   - It panics if the graph has negative edge weights, on which Dijkstra's algorithm silently returns wrong paths.
+func (u *Unit) computePaths(g *graph.Graph) {
+	if e := u.computePathsContext(context.Background(), g, nil); e != nil {
+		panic(negativeWeightMessage(e))
+	}
 }
 
-// weightedShortestPath computes the shortest path between two nodes in a weighted graph.
-// Uses Dijkstra's algorithm to calculate the path.
+// computePathsContext is the context-aware form of computePaths for a Unit.
+// One single-source search runs per node; the context is checked before each source node is processed.
 //
 // Parameters:
-//   - matrix: The adjacency matrix representation of the graph.
-//   - start: The starting node identifier.
-//   - end: The ending node identifier.
+//   - ctx: The context used to abort the computation.
+//   - g: The graph to perform the computation on.
+//   - progress: Called with the number of processed node pairs after each pair; nil disables reporting.
 //
 // Returns:
-//   - A graph.Path containing the shortest path and its total distance.
-func weightedShortestPath(matrix graph.Matrix, start, end graph.Identifier) *graph.Path {
-	n := len(matrix)
-
-	if int(start) >= n || int(end) >= n {
-		return graph.NewPath(graph.INF, []graph.Identifier{})
+//   - ctx.Err() if the computation was cancelled; the cached paths are then left invalid.
+//   - An error if the graph has negative edge weights, see checkNonNegative.
+func (u *Unit) computePathsContext(ctx context.Context, g *graph.Graph, progress func(done, total int)) error {
+	if u.adoptLoaded(g) {
+		return nil
 	}
 
-	dist := make([]graph.Distance, n)
-	prev := make([]int, n)
-	visited := make([]bool, n)
+	u.resetPaths()
 
-	for i := range dist {
-		dist[i] = graph.INF
-		prev[i] = -1
+	if e := checkNonNegative(g); e != nil {
+		return e
 	}
 
-	dist[start] = 0
+	forest := newPathForest(g)
+	n := len(forest.ids)
+	done, total := 0, n*(n-1)
 
-	for {
-		minDist := graph.INF
-		u := -1
-		for i := 0; i < n; i++ {
-			if !visited[i] && dist[i] < minDist {
-				minDist = dist[i]
-				u = i
-			}
+	for s, start := range forest.ids {
+		if err := ctx.Err(); err != nil {
+			return err
 		}
 
-		if u == -1 {
-			break
+		forest.setTree(s, searchFrom(g, start, start, false))
+
+		for i := 1; i < n && progress != nil; i++ {
+			done++
+			progress(done, total)
 		}
+	}
 
-		visited[u] = true
+	u.storePaths(forest)
+	u.markComputed(g)
 
-		for v := 0; v < n; v++ {
-			if matrix[u][v] > 0 && !visited[v] {
-				alt := dist[u] + matrix[u][v]
-				if alt < dist[v] {
-					dist[v] = alt
-					prev[v] = u
-				}
+	return nil
+}
+
+// computePaths calculates all shortest paths in parallel for a ParallelUnit.
+// After computation, the `shortestPaths` field in the ParallelUnit is updated and sorted by path distance in ascending order,
+// or, for a compact ParallelUnit, the per-source shortest path trees are stored instead.
+//
+// Parameters:
+//   - g: The graph to perform the computation on.
+//
+// Notes:
+//   - It panics if the graph has negative edge weights, on which Dijkstra's algorithm silently returns wrong paths.
+func (pu *ParallelUnit) computePaths(g *graph.Graph) {
+	if e := pu.computePathsContext(context.Background(), g, nil); e != nil {
+		panic(negativeWeightMessage(e))
+	}
+}
+
+// computePathsContext is the context-aware form of computePaths for a ParallelUnit.
+// Each source node is searched by a worker of the pool; workers stop picking up new sources as soon as the context is cancelled.
+//
+// Parameters:
+//   - ctx: The context used to abort the computation.
+//   - g: The graph to perform the computation on.
+//   - progress: Called with the number of processed node pairs after each pair; nil disables reporting.
+//     Calls are serialized, so the callback does not need to be thread-safe.
+//
+// Returns:
+//   - ctx.Err() if the computation was cancelled; the cached paths are then left invalid.
+//   - An error if the graph has negative edge weights, see checkNonNegative.
+func (pu *ParallelUnit) computePathsContext(ctx context.Context, g *graph.Graph, progress func(done, total int)) error {
+	if pu.adoptLoaded(g) {
+		return nil
+	}
+
+	pu.resetPaths()
+
+	if e := checkNonNegative(g); e != nil {
+		return e
+	}
+
+	forest := newPathForest(g)
+	n := len(forest.ids)
+	done, total := 0, n*(n-1)
+	var progressMu sync.Mutex
+
+	// Every source writes its own row of the forest, so the workers share no mutable state.
+	pu.parallelFor(n, func(s int) {
+		if ctx.Err() != nil {
+			return // Skip the remaining sources without computing them.
+		}
+
+		forest.setTree(s, searchFrom(g, forest.ids[s], forest.ids[s], false))
+
+		if progress != nil {
+			progressMu.Lock()
+			for i := 1; i < n; i++ {
+				done++
+				progress(done, total)
 			}
+			progressMu.Unlock()
 		}
+	})
+
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 
-	path := []graph.Identifier{}
+	pu.storePaths(forest)
+	pu.markComputed(g)
 
-	for at := int(end); at != -1; at = prev[at] {
-		path = append(path, graph.Identifier(at))
-	}
+	return nil
+}
 
-	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
-		path[i], path[j] = path[j], path[i]
+// checkNonNegative returns an error naming the first edge, in ascending order of its source, with a negative weight.
+func checkNonNegative(g *graph.Graph) error {
+	if !g.HasNegativeWeights() {
+		return nil
 	}
 
-	if dist[end] == graph.INF {
-		return graph.NewPath(graph.INF, []graph.Identifier{})
+	for _, id := range sortedIDs(g) {
+		for _, e := range g.NeighborEdges(id) {
+			if int64(e.Distance()) < 0 {
+				return err.NegativeWeight(id.String(), e.To().String())
+			}
+		}
 	}
 
-	return graph.NewPath(dist[end], path)
+	return nil
+}
+
+// negativeWeightMessage turns the error of checkNonNegative into a panic message pointing to the algorithms that
+// support negative weights.
+func negativeWeightMessage(e error) string {
+	return fmt.Sprintf("algorithm: %v; shortest paths on graphs with negative weights need BellmanFord or Johnson", e)
+}
+
+// weightedShortestPath computes the shortest path between two nodes in a weighted graph.
+// Uses Dijkstra's algorithm with a binary heap over the graph's adjacency lists.
+//
+// Parameters:
+//   - g: The graph to perform the computation on.
+//   - start: The starting node identifier.
+//   - end: The ending node identifier.
+//
+// Returns:
+//   - A graph.Path containing the shortest path and its total distance.
+func weightedShortestPath(g *graph.Graph, start, end graph.Identifier) *graph.Path {
+	return searchPath(g, start, end)
 }
 
 // unweightedShortestPath computes the shortest path between two nodes in an unweighted graph.
-// Uses BFS to calculate the path.
+// Uses BFS over the graph's adjacency lists to calculate the path.
 //
 // Parameters:
-//   - matrix: The adjacency matrix representation of the graph.
+//   - g: The graph to perform the computation on.
 //   - start: The starting node identifier.
 //   - end: The ending node identifier.
 //
 // Returns:
 //   - A graph.Path containing the shortest path and its total distance.
-func unweightedShortestPath(matrix graph.Matrix, start, end graph.Identifier) *graph.Path {
-	n := len(matrix)
+func unweightedShortestPath(g *graph.Graph, start, end graph.Identifier) *graph.Path {
+	return searchPath(g, start, end)
+}
 
-	if int(start) >= n || int(end) >= n {
+// searchPath runs a single-source search from start that stops once end is settled, and traces the path.
+func searchPath(g *graph.Graph, start, end graph.Identifier) *graph.Path {
+	if _, err := g.FindNode(start); err != nil {
+		return graph.NewPath(graph.INF, []graph.Identifier{})
+	}
+	if _, err := g.FindNode(end); err != nil {
 		return graph.NewPath(graph.INF, []graph.Identifier{})
 	}
 
-	dist := make([]graph.Distance, n)
-	prev := make([]int, n)
+	tree := searchFrom(g, start, end, true)
 
-	for i := range dist {
-		dist[i] = graph.INF
-		prev[i] = -1
+	if _, ok := tree.dist[end]; !ok {
+		return graph.NewPath(graph.INF, []graph.Identifier{})
 	}
 
-	queue := []int{int(start)}
-	dist[start] = 0
+	return graph.NewPath(tree.dist[end], tracePath(tree.prev, start, end))
+}
 
-	for len(queue) > 0 {
-		u := queue[0]
-		queue = queue[1:]
+// searchTree is the result of a single-source search: distances and predecessors of the settled nodes.
+type searchTree struct {
+	dist map[graph.Identifier]graph.Distance   // Distance from the source to each reached node.
+	prev map[graph.Identifier]graph.Identifier // Predecessor of each reached node other than the source.
+}
 
-		for v := 0; v < n; v++ {
-			if matrix[u][v] == 1 && dist[v] == graph.INF {
-				dist[v] = dist[u] + 1
-				prev[v] = u
-				queue = append(queue, v)
+// searchFrom runs Dijkstra's algorithm for weighted graphs, or BFS for unweighted graphs, from start.
+// With stop set the search ends as soon as end is settled; otherwise every reachable node is settled.
+// Nodes settled before end receive the same predecessors either way, so a full search yields exactly the
+// paths of the pairwise searches used by ShortestPath.
+//
+// Parameters:
+//   - g: The graph to perform the computation on.
+//   - start: The source node identifier, which must exist.
+//   - end: The node identifier at which to stop; ignored unless stop is set.
+//   - stop: Whether to stop once end is settled.
+//
+// Returns:
+//   - The distances and predecessors of the reached nodes.
+func searchFrom(g *graph.Graph, start, end graph.Identifier, stop bool) searchTree {
+	tree := searchTree{
+		dist: map[graph.Identifier]graph.Distance{start: 0},
+		prev: make(map[graph.Identifier]graph.Identifier),
+	}
+
+	if g.Type() != graph.DirectedWeighted && g.Type() != graph.UndirectedWeighted {
+		queue := []graph.Identifier{start}
+
+		for len(queue) > 0 && !(stop && queue[0] == end) {
+			u := queue[0]
+			queue = queue[1:]
+
+			for _, v := range g.Neighbors(u) {
+				if _, seen := tree.dist[v]; !seen {
+					tree.dist[v] = tree.dist[u] + 1
+					tree.prev[v] = u
+					queue = append(queue, v)
+				}
 			}
 		}
+
+		return tree
 	}
 
-	path := []graph.Identifier{}
+	visited := make(map[graph.Identifier]bool)
+	queue := &distanceHeap{{node: start, distance: 0}}
+
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(distanceItem)
+		u := item.node
+
+		if visited[u] {
+			continue
+		}
 
-	for at := int(end); at != -1; at = prev[at] {
-		path = append(path, graph.Identifier(at))
+		visited[u] = true
+
+		if stop && u == end {
+			break
+		}
+
+		for _, e := range g.NeighborEdges(u) {
+			v := e.To()
+
+			if visited[v] {
+				continue
+			}
+
+			alt := tree.dist[u] + e.Distance()
+			if d, ok := tree.dist[v]; !ok || alt < d {
+				tree.dist[v] = alt
+				tree.prev[v] = u
+				heap.Push(queue, distanceItem{node: v, distance: alt})
+			}
+		}
+	}
+
+	return tree
+}
+
+// tracePath rebuilds the node sequence from start to end by following predecessors backwards.
+func tracePath(prev map[graph.Identifier]graph.Identifier, start, end graph.Identifier) []graph.Identifier {
+	path := []graph.Identifier{end}
+
+	for at := end; at != start; {
+		at = prev[at]
+		path = append(path, at)
 	}
 
 	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
 		path[i], path[j] = path[j], path[i]
 	}
 
-	if dist[end] == graph.INF {
-		return graph.NewPath(graph.INF, []graph.Identifier{})
+	return path
+}
+
+// distanceItem is an entry of distanceHeap: a node and its tentative distance.
+type distanceItem struct {
+	node     graph.Identifier
+	distance graph.Distance
+}
+
+// distanceHeap is a min-heap of distanceItem ordered by distance, used by Dijkstra's algorithm.
+type distanceHeap []distanceItem
+
+func (h distanceHeap) Len() int            { return len(h) }
+func (h distanceHeap) Less(i, j int) bool  { return h[i].distance < h[j].distance }
+func (h distanceHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *distanceHeap) Push(x interface{}) { *h = append(*h, x.(distanceItem)) }
+func (h *distanceHeap) Pop() interface{} {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
+
+// lessPath orders paths by distance, breaking ties by their start and end nodes.
+// The total order makes the cached paths, and everything aggregated over them, independent of
+// the order in which the paths were computed.
+func lessPath(a, b graph.Path) bool {
+	if a.Distance() != b.Distance() {
+		return a.Distance() < b.Distance()
+	}
+
+	an, bn := a.Nodes(), b.Nodes()
+
+	if an[0] != bn[0] {
+		return an[0] < bn[0]
 	}
 
-	return graph.NewPath(dist[end], path)
+	return an[len(an)-1] < bn[len(bn)-1]
 }
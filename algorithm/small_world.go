@@ -0,0 +1,52 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// SmallWorldSigma computes the small-world coefficient σ of Humphries and Gurney. The clustering coefficient C
+// and the average shortest path length L of the graph are compared with those of an Erdős–Rényi random graph
+// with the same number of nodes and the same edge density:
+//
+//	σ = (C / C_random) / (L / L_random)
+//
+// Parameters:
+//   - g: The graph to analyze.
+//   - seed: The seed of the random reference graph, making the result reproducible.
+//
+// Returns:
+//   - The small-world coefficient. Values well above 1 indicate a small world: clustering much higher than
+//     chance with paths about as short. Returns 0 if it is undefined, i.e. if either graph has no triangles
+//     or no connected pairs.
+//
+// Notes:
+//   - The reference graph is a single-block GenerateSBM sample, and the coefficients are those of
+//     ClusteringCoefficient (the average local coefficient) and AverageShortestPathLength.
+//   - The measure is defined for undirected unweighted graphs; directed edges are counted as undirected
+//     for the density, and weights are ignored by the reference graph but not by L.
+//   - A single reference graph is sampled, so small graphs give noisy results; average over several seeds if needed.
+//     Average path lengths only cover connected pairs, so very sparse references are misleading.
+func SmallWorldSigma(g *graph.Graph, seed int64) float64 {
+	n := g.NodeCount()
+	if n < 3 {
+		return 0
+	}
+
+	density := float64(g.EdgeCount()) / float64(n*(n-1)/2)
+	random, _, e := GenerateSBM([]int{n}, [][]float64{{min(1, density)}}, seed)
+	if e != nil {
+		return 0
+	}
+
+	_, clustering := NewUnit().ClusteringCoefficient(g)
+	_, randomClustering := NewUnit().ClusteringCoefficient(random)
+
+	pathLength := NewUnit().AverageShortestPathLength(g)
+	randomPathLength := NewUnit().AverageShortestPathLength(random)
+
+	if clustering == 0 || randomClustering == 0 || pathLength == 0 || randomPathLength == 0 {
+		return 0
+	}
+
+	return (clustering / randomClustering) / (pathLength / randomPathLength)
+}
@@ -0,0 +1,114 @@
+package algorithm
+
+import (
+	"sort"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// spanningEdge is an undirected edge considered for a minimum spanning tree.
+type spanningEdge struct {
+	pair   [2]graph.Identifier // Endpoints, smaller identifier first.
+	weight graph.Distance      // Weight of the edge.
+}
+
+// IsMSTUnique reports whether the graph has exactly one minimum spanning tree. A minimum spanning tree is built
+// with Kruskal's algorithm; it is unique unless some edge outside the tree weighs as much as the heaviest
+// tree edge on the cycle it closes, in which case swapping the two gives another tree of the same weight.
+//
+// Parameters:
+//   - g: The graph to analyze. Unweighted edges all weigh 1.
+//
+// Returns:
+//   - true if the minimum spanning tree is unique, false otherwise.
+//
+// Notes:
+//   - Disconnected graphs are checked for a unique minimum spanning forest.
+//   - Edge directions are ignored; in a directed graph, or in multigraph mode, two edges between the same
+//     nodes are alternatives, so equal weights make the tree ambiguous. Self-loops are never part of a tree.
+//   - Checking every non-tree edge against its cycle costs O(m·n); graphs whose weights are all distinct always
+//     have a unique tree.
+func IsMSTUnique(g *graph.Graph) bool {
+	ids := sortedIDs(g)
+	edges := []spanningEdge{}
+
+	for _, id := range ids {
+		for _, e := range g.NeighborEdges(id) {
+			// Undirected edges are stored at both ends; keep the copy at the smaller identifier.
+			if e.To() == id || (!g.Directed() && e.To() < id) {
+				continue
+			}
+
+			edges = append(edges, spanningEdge{pair: orderedPair(id, e.To()), weight: e.Distance()})
+		}
+	}
+
+	sort.SliceStable(edges, func(i, j int) bool { return edges[i].weight < edges[j].weight })
+
+	// Kruskal's algorithm with a union-find forest.
+	root := make(map[graph.Identifier]graph.Identifier, len(ids))
+	for _, id := range ids {
+		root[id] = id
+	}
+
+	var find func(graph.Identifier) graph.Identifier
+	find = func(v graph.Identifier) graph.Identifier {
+		if root[v] != v {
+			root[v] = find(root[v])
+		}
+
+		return root[v]
+	}
+
+	tree := make(map[graph.Identifier][]spanningEdge, len(ids))
+	rest := []spanningEdge{}
+
+	for _, edge := range edges {
+		a, b := find(edge.pair[0]), find(edge.pair[1])
+
+		if a == b {
+			rest = append(rest, edge)
+			continue
+		}
+
+		root[a] = b
+		tree[edge.pair[0]] = append(tree[edge.pair[0]], edge)
+		tree[edge.pair[1]] = append(tree[edge.pair[1]], spanningEdge{pair: [2]graph.Identifier{edge.pair[1], edge.pair[0]}, weight: edge.weight})
+	}
+
+	for _, edge := range rest {
+		if heaviestTreeEdge(tree, edge.pair[0], edge.pair[1]) == edge.weight {
+			return false
+		}
+	}
+
+	return true
+}
+
+// heaviestTreeEdge returns the largest edge weight on the path between two nodes of a spanning forest,
+// whose adjacency lists hold edges as {node, neighbor} pairs. The nodes must be in the same tree.
+func heaviestTreeEdge(tree map[graph.Identifier][]spanningEdge, from, to graph.Identifier) graph.Distance {
+	// heaviest[v] is the largest weight on the tree path from `from` to v.
+	heaviest := map[graph.Identifier]graph.Distance{from: 0}
+	stack := []graph.Identifier{from}
+
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if v == to {
+			break
+		}
+
+		for _, edge := range tree[v] {
+			next := edge.pair[1]
+
+			if _, seen := heaviest[next]; !seen {
+				heaviest[next] = max(heaviest[v], edge.weight)
+				stack = append(stack, next)
+			}
+		}
+	}
+
+	return heaviest[to]
+}
@@ -0,0 +1,250 @@
+package algorithm
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+const (
+	spectralMaxIter = 1000 // Iteration limit of the inverse power iteration used by SpectralBisection.
+	spectralTol     = 1e-9 // Convergence tolerance of the inverse power iteration used by SpectralBisection.
+)
+
+// AlgebraicConnectivity computes the algebraic connectivity of the graph, the second-smallest eigenvalue
+// of its Laplacian matrix. It is 0 exactly when the graph is disconnected, and grows with the number of
+// edges that must be removed to split the graph, which makes it a robustness indicator.
+//
+// Parameters:
+//   - g: The graph to analyze. Weighted edges contribute their weight.
+//   - maxIter: The maximum number of inverse power iterations.
+//   - tol: The convergence tolerance on the L1 change of the eigenvector between iterations.
+//
+// Returns:
+//   - The algebraic connectivity, or 0 if the graph has fewer than two nodes or is disconnected.
+//
+// Notes:
+//   - The Laplacian is symmetrized: for directed graphs the weights of both directions between two nodes are summed.
+//   - The eigenvalue is found by inverse power iteration on the pseudo-inverse of the Laplacian,
+//     which costs O(n³) once plus O(n²) per iteration.
+func AlgebraicConnectivity(g *graph.Graph, maxIter int, tol float64) float64 {
+	value, _ := fiedler(g, maxIter, tol)
+	return value
+}
+
+// FiedlerVector computes the eigenvector of the Laplacian matrix associated with the algebraic connectivity.
+// The signs of its entries split the graph into two well-connected halves, which is the basis of spectral bisection.
+//
+// Parameters:
+//   - g: The graph to analyze. Weighted edges contribute their weight.
+//   - maxIter: The maximum number of inverse power iterations.
+//   - tol: The convergence tolerance on the L1 change of the eigenvector between iterations.
+//
+// Returns:
+//   - A map from each node identifier to its entry of the unit-length Fiedler vector, or an empty map
+//     if the graph has fewer than two nodes. The sign is chosen so the entry of the smallest identifier is not positive.
+//
+// Notes:
+//   - For a disconnected graph the eigenvalue 0 has several eigenvectors; the one returned separates the
+//     component of the smallest identifier from the rest of the graph.
+func FiedlerVector(g *graph.Graph, maxIter int, tol float64) map[graph.Identifier]float64 {
+	_, vector := fiedler(g, maxIter, tol)
+	return vector
+}
+
+// SpectralBisection partitions the graph into two halves by the signs of its Fiedler vector.
+// Nodes joined by many edges receive similar Fiedler entries, so the split tends to cut few edges
+// while keeping both halves well connected, which suits divide-and-conquer processing.
+//
+// Parameters:
+//   - g: The graph to partition. Weighted edges contribute their weight.
+//
+// Returns:
+//   - The nodes whose Fiedler entry is not positive, in ascending order. It contains the smallest identifier.
+//   - The nodes whose Fiedler entry is positive, in ascending order.
+//
+// Notes:
+//   - The Fiedler vector is computed as in FiedlerVector, with a fixed iteration limit and tolerance.
+//   - For a disconnected graph the split separates the component of the smallest identifier from the rest.
+//   - The halves need not have equal sizes; refine the result with KernighanLin for a balanced minimum cut.
+func SpectralBisection(g *graph.Graph) ([]graph.Identifier, []graph.Identifier) {
+	_, vector := fiedler(g, spectralMaxIter, spectralTol)
+	a, b := []graph.Identifier{}, []graph.Identifier{}
+
+	for _, id := range sortedIDs(g) {
+		if vector[id] <= 0 {
+			a = append(a, id)
+		} else {
+			b = append(b, id)
+		}
+	}
+
+	return a, b
+}
+
+// fiedler computes the algebraic connectivity and the Fiedler vector of the graph.
+func fiedler(g *graph.Graph, maxIter int, tol float64) (float64, map[graph.Identifier]float64) {
+	ids := sortedIDs(g)
+	n := len(ids)
+	result := make(map[graph.Identifier]float64, n)
+
+	if n < 2 {
+		return 0, result
+	}
+
+	var vector []float64
+	value := 0.0
+
+	if components := WeaklyConnectedComponents(g); len(components) > 1 {
+		// Any vector constant on each component is in the null space; balance it to be orthogonal to 1.
+		first := make(map[graph.Identifier]bool, len(components[0]))
+		for _, id := range components[0] {
+			first[id] = true
+		}
+
+		inside, outside := 1/float64(len(components[0])), -1/float64(n-len(components[0]))
+		vector = make([]float64, n)
+
+		for i, id := range ids {
+			if first[id] {
+				vector[i] = inside
+			} else {
+				vector[i] = outside
+			}
+		}
+
+		normalizeL2(vector)
+	} else {
+		laplacian := symmetricLaplacian(g, ids)
+		value, vector = inverseIteration(laplacian, maxIter, tol)
+	}
+
+	// Fix the arbitrary sign of the eigenvector.
+	if vector[0] > 0 {
+		for i := range vector {
+			vector[i] = -vector[i]
+		}
+	}
+
+	for i, id := range ids {
+		result[id] = vector[i]
+	}
+
+	return value, result
+}
+
+// symmetricLaplacian builds the Laplacian matrix of the graph over the given nodes, indexed by position in ids.
+// For directed graphs the weights of both directions between two nodes are summed.
+func symmetricLaplacian(g *graph.Graph, ids []graph.Identifier) [][]float64 {
+	full := g.LaplacianMatrix()
+	laplacian := make([][]float64, len(ids))
+
+	for i, from := range ids {
+		laplacian[i] = make([]float64, len(ids))
+
+		for j, to := range ids {
+			if i == j {
+				continue
+			}
+
+			laplacian[i][j] = full[from][to]
+			if g.Directed() {
+				laplacian[i][j] += full[to][from]
+			}
+
+			laplacian[i][i] -= laplacian[i][j]
+		}
+	}
+
+	return laplacian
+}
+
+// inverseIteration finds the smallest non-zero eigenvalue of the Laplacian of a connected graph and its eigenvector.
+// Adding J/n, the all-ones matrix divided by n, lifts the zero eigenvalue of the constant vector to 1 without
+// changing the other eigenpairs, so the matrix can be inverted; on vectors orthogonal to the constant vector its
+// inverse acts as the pseudo-inverse of the Laplacian, whose dominant eigenvector is the Fiedler vector.
+//
+// Parameters:
+//   - laplacian: The symmetric Laplacian matrix of a connected graph.
+//   - maxIter: The maximum number of iterations.
+//   - tol: The convergence tolerance on the L1 change of the eigenvector between iterations.
+//
+// Returns:
+//   - The eigenvalue, as the Rayleigh quotient of the final vector.
+//   - The unit-length eigenvector.
+func inverseIteration(laplacian [][]float64, maxIter int, tol float64) (float64, []float64) {
+	n := len(laplacian)
+
+	shifted := make([][]float64, n)
+	for i := range laplacian {
+		shifted[i] = make([]float64, n)
+		for j := range laplacian[i] {
+			shifted[i][j] = laplacian[i][j] + 1/float64(n)
+		}
+	}
+
+	inverse := invertMatrix(shifted)
+
+	// A fixed pseudo-random start is unlikely to be orthogonal to the Fiedler vector, yet reproducible.
+	r := rand.New(rand.NewSource(1))
+	vector := make([]float64, n)
+	for i := range vector {
+		vector[i] = r.Float64() - 0.5
+	}
+
+	centerVector(vector)
+	normalizeL2(vector)
+
+	for iter := 0; iter < maxIter; iter++ {
+		next := multiplyMatrix(inverse, vector)
+
+		// Remove drift toward the constant vector before normalizing.
+		centerVector(next)
+		normalizeL2(next)
+
+		diff := 0.0
+		for i := range next {
+			diff += math.Abs(next[i] - vector[i])
+		}
+
+		vector = next
+
+		if diff < tol {
+			break
+		}
+	}
+
+	value := 0.0
+	for i, x := range multiplyMatrix(laplacian, vector) {
+		value += vector[i] * x
+	}
+
+	return value, vector
+}
+
+// multiplyMatrix returns the product of a square matrix and a vector.
+func multiplyMatrix(m [][]float64, vector []float64) []float64 {
+	result := make([]float64, len(m))
+
+	for i, row := range m {
+		for j, value := range row {
+			result[i] += value * vector[j]
+		}
+	}
+
+	return result
+}
+
+// centerVector subtracts the mean from every entry, making the vector orthogonal to the constant vector.
+func centerVector(vector []float64) {
+	mean := 0.0
+	for _, x := range vector {
+		mean += x
+	}
+	mean /= float64(len(vector))
+
+	for i := range vector {
+		vector[i] -= mean
+	}
+}
@@ -0,0 +1,91 @@
+package algorithm
+
+import (
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// TriangleParticipation counts the triangles each node of the graph belongs to for a Unit.
+// A triangle is a set of three nodes that are pairwise adjacent; nodes in many triangles are tightly
+// embedded in their neighborhood, and the count is the numerator of the local clustering coefficient.
+//
+// Parameters:
+//   - g: The graph to count the triangles of.
+//
+// Returns:
+//   - A map where the keys are node identifiers and the values are the number of triangles through each node.
+//
+// Notes:
+//   - Edge directions are ignored, so a directed graph is counted as its underlying undirected graph,
+//     and reciprocal or parallel edges count once. Self-loops are ignored; zero-weight edges count.
+//   - The cost is O(Σ k²) adjacency lookups for node degrees k.
+func (u *Unit) TriangleParticipation(g *graph.Graph) map[graph.Identifier]int {
+	ids, adjacency := triangleAdjacency(g)
+	triangles := make(map[graph.Identifier]int, len(ids))
+
+	for _, id := range ids {
+		triangles[id] = trianglesAt(adjacency, id)
+	}
+
+	return triangles
+}
+
+// ParallelUnit version of TriangleParticipation.
+// Counts the triangles of each node using parallel computations, one node per job.
+func (pu *ParallelUnit) TriangleParticipation(g *graph.Graph) map[graph.Identifier]int {
+	ids, adjacency := triangleAdjacency(g)
+	counts := make([]int, len(ids))
+
+	pu.parallelFor(len(ids), func(i int) {
+		counts[i] = trianglesAt(adjacency, ids[i])
+	})
+
+	triangles := make(map[graph.Identifier]int, len(ids))
+	for i, id := range ids {
+		triangles[id] = counts[i]
+	}
+
+	return triangles
+}
+
+// triangleAdjacency returns the sorted node identifiers and the neighbor sets of the underlying
+// undirected simple graph, without self-loops and edges to removed nodes.
+// The sets are only read afterwards, so they can be shared by workers.
+func triangleAdjacency(g *graph.Graph) ([]graph.Identifier, map[graph.Identifier]map[graph.Identifier]bool) {
+	ids := sortedIDs(g)
+	adjacency := make(map[graph.Identifier]map[graph.Identifier]bool, len(ids))
+
+	for _, id := range ids {
+		adjacency[id] = make(map[graph.Identifier]bool)
+	}
+
+	for _, id := range ids {
+		for _, to := range g.Neighbors(id) {
+			// Skip self-loops and edges to removed nodes, which RemoveNode leaves behind.
+			if _, exists := adjacency[to]; exists && to != id {
+				adjacency[id][to] = true
+				adjacency[to][id] = true
+			}
+		}
+	}
+
+	return ids, adjacency
+}
+
+// trianglesAt counts the pairs of neighbors of a node that are adjacent to each other.
+func trianglesAt(adjacency map[graph.Identifier]map[graph.Identifier]bool, id graph.Identifier) int {
+	neighbors := make([]graph.Identifier, 0, len(adjacency[id]))
+	for to := range adjacency[id] {
+		neighbors = append(neighbors, to)
+	}
+
+	count := 0
+	for i := 0; i < len(neighbors); i++ {
+		for j := i + 1; j < len(neighbors); j++ {
+			if adjacency[neighbors[i]][neighbors[j]] {
+				count++
+			}
+		}
+	}
+
+	return count
+}
@@ -10,9 +10,17 @@ import (
 // Fields:
 //   - shortestPaths: A slice of all shortest paths in the graph, sorted by their distance in ascending order.
 //   - updated: A boolean indicating whether the paths are up-to-date or if the graph has been modified.
+//   - compact: A boolean indicating whether predecessor trees are stored instead of explicit paths.
+//   - trees: The per-source shortest path trees of a compact Unit.
+//   - fingerprint: The structure of the graph the cached paths were computed for, see Save.
+//   - loaded: A boolean indicating whether the paths were read by LoadUnit and not yet matched against a graph.
 type Unit struct {
 	shortestPaths []graph.Path // Stores the shortest paths for the graph, sorted by distance in ascending order.
 	updated       bool         // Indicates whether the data needs to be recalculated.
+	compact       bool         // Stores predecessor trees instead of explicit paths, see SetCompactPaths.
+	trees         *pathForest  // Stores the shortest path trees of a compact Unit; nil otherwise.
+	fingerprint   uint64       // Hash of the graph structure matching the cached paths.
+	loaded        bool         // Indicates that the paths were loaded and await a graph with the same fingerprint.
 }
 
 // ParallelUnit is an extension of Unit for parallel computation.
@@ -20,7 +28,7 @@ type Unit struct {
 //
 // Fields:
 //   - Unit: Embeds the base Unit structure for algorithm computations.
-//   - maxCore: The maximum number of cores to be used for parallel processing.
+//   - maxCore: The maximum number of cores to be used for parallel processing, i.e. the size of the worker pool.
 type ParallelUnit struct {
 	Unit         // Embeds the Unit structure for shared functionality.
 	maxCore uint // Maximum number of CPU cores to use for parallel computation.
@@ -38,7 +46,8 @@ func NewUnit() *Unit {
 // NewParallelUnit creates and initializes a new ParallelUnit instance.
 //
 // Parameters:
-//   - core: The maximum number of cores to use for parallel computations.
+//   - core: The maximum number of cores to use for parallel computations. Every parallel computation runs
+//     on a worker pool of this size; 0 uses runtime.NumCPU(). It can be changed later with SetMaxWorkers.
 //
 // Returns a pointer to the newly created ParallelUnit.
 func NewParallelUnit(core uint) *ParallelUnit {
@@ -0,0 +1,196 @@
+package algorithm
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"hash/fnv"
+	"io"
+	"sort"
+
+	err "github.com/elecbug/go-graphtric/err" // Custom error package
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// unitJSON is the serialized form of the shortest path cache of a Unit.
+type unitJSON struct {
+	Fingerprint uint64             `json:"fingerprint"`     // Structure of the graph the paths were computed for.
+	Compact     bool               `json:"compact"`         // Whether the cache holds predecessor trees.
+	Paths       []pathJSON         `json:"paths,omitempty"` // The explicit paths in sorted order, if not compact.
+	IDs         []graph.Identifier `json:"ids,omitempty"`   // The node identifiers indexing the trees, if compact.
+	Dist        [][]graph.Distance `json:"dist,omitempty"`  // The distances of the trees, if compact.
+	Prev        [][]int32          `json:"prev,omitempty"`  // The predecessor positions of the trees, if compact.
+}
+
+// pathJSON is the serialized form of a graph.Path.
+type pathJSON struct {
+	Distance graph.Distance     `json:"distance"` // Total distance of the path.
+	Nodes    []graph.Identifier `json:"nodes"`    // Nodes from the source to the destination.
+}
+
+// Save writes the shortest path cache of a Unit as JSON, so that a later run can skip the computation with LoadUnit.
+// Explicit paths are written as such; a compact Unit writes its predecessor trees instead.
+//
+// Parameters:
+//   - w: The writer to encode the cache to.
+//
+// Returns:
+//   - An error if the Unit holds no computed paths, e.g. before Precompute, or if writing fails.
+//
+// Notes:
+//   - Only the paths and a fingerprint of the graph structure are written, not the graph itself;
+//     save the graph separately, e.g. with json.Marshal.
+func (u *Unit) Save(w io.Writer) error {
+	if !u.updated && !u.loaded {
+		return err.InvalidArgument("unit", "shortest paths not computed")
+	}
+
+	data := unitJSON{Fingerprint: u.fingerprint, Compact: u.compact}
+
+	if u.trees != nil {
+		data.IDs, data.Dist, data.Prev = u.trees.ids, u.trees.dist, u.trees.prev
+	} else {
+		data.Paths = make([]pathJSON, len(u.shortestPaths))
+		for i, path := range u.shortestPaths {
+			data.Paths[i] = pathJSON{Distance: path.Distance(), Nodes: path.Nodes()}
+		}
+	}
+
+	return json.NewEncoder(w).Encode(data)
+}
+
+// LoadUnit reads a shortest path cache written by Save into a new Unit.
+//
+// Parameters:
+//   - r: The reader to decode the cache from.
+//
+// Returns:
+//   - A Unit holding the loaded paths, in the mode they were saved in.
+//   - An error if the data is malformed.
+//
+// Notes:
+//   - The loaded paths are only used for a graph with the same structure as the one they were computed for:
+//     the same node identifiers and the same edges with the same weights. Attach checks this explicitly;
+//     otherwise the first metric call checks it, and recomputes the paths if the graph does not match.
+//   - Until then IsComputed reports false.
+func LoadUnit(r io.Reader) (*Unit, error) {
+	var data unitJSON
+
+	if e := json.NewDecoder(r).Decode(&data); e != nil {
+		return nil, e
+	}
+
+	u := NewUnit()
+	u.compact = data.Compact
+	u.fingerprint = data.Fingerprint
+
+	if data.Compact {
+		n := len(data.IDs)
+		if len(data.Dist) != n || len(data.Prev) != n {
+			return nil, err.InvalidFormat("unit", "tree count does not match node count")
+		}
+
+		forest := &pathForest{ids: data.IDs, index: make(map[graph.Identifier]int, n), dist: data.Dist, prev: data.Prev}
+
+		for i, id := range data.IDs {
+			forest.index[id] = i
+
+			if len(data.Dist[i]) != n || len(data.Prev[i]) != n {
+				return nil, err.InvalidFormat("unit", "tree size does not match node count")
+			}
+
+			for _, p := range data.Prev[i] {
+				if p < -1 || int(p) >= n {
+					return nil, err.InvalidFormat("unit", "predecessor out of range")
+				}
+			}
+		}
+
+		u.trees = forest
+	} else {
+		for _, path := range data.Paths {
+			if len(path.Nodes) < 2 {
+				return nil, err.InvalidFormat("unit", "path with fewer than two nodes")
+			}
+
+			u.shortestPaths = append(u.shortestPaths, *graph.NewPath(path.Distance, path.Nodes))
+		}
+	}
+
+	u.loaded = true
+
+	return u, nil
+}
+
+// Attach checks that the cached paths of a Unit describe a graph and, if so, marks them up to date for it,
+// so that the next metric call reads them instead of recomputing them.
+// It is the explicit form of the check a loaded Unit makes on its first metric call.
+//
+// Parameters:
+//   - g: The graph the paths are meant for.
+//
+// Returns:
+//   - An error if the Unit holds no paths or g differs in structure from the graph they were computed for.
+//     The cache is left unchanged in that case.
+//
+// Notes:
+//   - The structure covers the graph type, node identifiers, and edges with their weights; node names, node weights,
+//     and edge labels do not affect shortest paths and are not compared.
+func (u *Unit) Attach(g *graph.Graph) error {
+	if !u.updated && !u.loaded {
+		return err.InvalidArgument("unit", "shortest paths not computed")
+	}
+
+	if graphFingerprint(g) != u.fingerprint {
+		return err.InvalidArgument("graph", "structure does not match the cached paths")
+	}
+
+	g.Update()
+	u.updated = true
+	u.loaded = false
+
+	return nil
+}
+
+// adoptLoaded attaches loaded paths to g on the first computation, reporting whether they could be used.
+func (u *Unit) adoptLoaded(g *graph.Graph) bool {
+	return u.loaded && u.Attach(g) == nil
+}
+
+// markComputed records that the cached paths are up to date for g.
+func (u *Unit) markComputed(g *graph.Graph) {
+	g.Update()
+	u.updated = true
+	u.fingerprint = graphFingerprint(g)
+}
+
+// graphFingerprint hashes the structure of a graph: its type, node identifiers, and weighted edges.
+// Edges are hashed in ascending order of source and destination, so the order of insertion does not matter.
+func graphFingerprint(g *graph.Graph) uint64 {
+	hash := fnv.New64a()
+	write := func(values ...uint64) {
+		for _, value := range values {
+			binary.Write(hash, binary.LittleEndian, value)
+		}
+	}
+
+	write(uint64(g.Type()))
+
+	for _, id := range sortedIDs(g) {
+		edges := g.NeighborEdges(id)
+		sort.Slice(edges, func(i, j int) bool {
+			if edges[i].To() != edges[j].To() {
+				return edges[i].To() < edges[j].To()
+			}
+
+			return edges[i].Distance() < edges[j].Distance()
+		})
+
+		write(uint64(id), uint64(len(edges)))
+
+		for _, e := range edges {
+			write(uint64(e.To()), uint64(e.Distance()))
+		}
+	}
+
+	return hash.Sum64()
+}
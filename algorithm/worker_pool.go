@@ -0,0 +1,75 @@
+package algorithm
+
+import (
+	"runtime"
+	"sync"
+)
+
+// SetMaxWorkers sets the maximum number of goroutines a ParallelUnit runs at once.
+// Every parallel computation uses a worker pool of this size, so the number of in-flight
+// goroutines stays bounded regardless of the size of the graph.
+//
+// Parameters:
+//   - workers: The maximum number of worker goroutines. A value of 0 uses runtime.NumCPU().
+func (pu *ParallelUnit) SetMaxWorkers(workers uint) {
+	pu.maxCore = workers
+}
+
+// workers returns the size of the worker pool, falling back to runtime.NumCPU() if unset.
+func (pu *ParallelUnit) workers() int {
+	if pu.maxCore == 0 {
+		return runtime.NumCPU()
+	}
+
+	return int(pu.maxCore)
+}
+
+// parallelFor calls fn for every index in [0, n) on the worker pool of the ParallelUnit.
+// Indices are handed out through a channel, so at most workers() calls run at once.
+// fn may run concurrently with itself and must only write to state owned by its index.
+//
+// Parameters:
+//   - n: The number of indices.
+//   - fn: The function called once per index.
+func (pu *ParallelUnit) parallelFor(n int, fn func(i int)) {
+	jobChan := make(chan int)
+	workerCount := min(pu.workers(), n)
+
+	var wg sync.WaitGroup
+	wg.Add(workerCount)
+
+	for w := 0; w < workerCount; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobChan {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobChan <- i
+	}
+
+	close(jobChan)
+	wg.Wait()
+}
+
+// chunkRanges splits [0, n) into at most workers() contiguous ranges of nearly equal size.
+// Each range can be processed by one parallelFor call into its own partial result, keeping
+// the memory of intermediate results proportional to the number of workers.
+//
+// Parameters:
+//   - n: The number of items to split.
+//
+// Returns a slice of [start, end) ranges covering [0, n) in order.
+func (pu *ParallelUnit) chunkRanges(n int) [][2]int {
+	count := min(pu.workers(), n)
+	ranges := make([][2]int, 0, count)
+
+	for c := 0; c < count; c++ {
+		ranges = append(ranges, [2]int{c * n / count, (c + 1) * n / count})
+	}
+
+	return ranges
+}
@@ -23,3 +23,31 @@ func AlreadyNode(key string) error {
 func NotExistNode(key string) error {
 	return fmt.Errorf("node not exist: [%s]", key)
 }
+
+func NotExistEdge(fromKey, toKey string) error {
+	return fmt.Errorf("edge not exist: [%s ---> %s]", fromKey, toKey)
+}
+
+func NegativeCycle(key string) error {
+	return fmt.Errorf("negative cycle reachable from node: [%s]", key)
+}
+
+func CyclicGraph(key string) error {
+	return fmt.Errorf("graph contains a cycle through node: [%s]", key)
+}
+
+func InvalidArgument(name, key string) error {
+	return fmt.Errorf("invalid argument %s: [%s]", name, key)
+}
+
+func InvalidFormat(formatKey, key string) error {
+	return fmt.Errorf("invalid %s document: [%s]", formatKey, key)
+}
+
+func NotConverged(key string) error {
+	return fmt.Errorf("iteration did not converge: [%s]", key)
+}
+
+func NegativeWeight(fromKey, toKey string) error {
+	return fmt.Errorf("negative edge weight: [%s ---> %s]", fromKey, toKey)
+}
@@ -0,0 +1,90 @@
+package graph
+
+import "sort"
+
+// NeighborsAtDistance returns the nodes exactly k hops away from a source node.
+// A breadth-first search following outgoing edges collects the frontier at depth k,
+// which is useful for ring-based sampling.
+//
+// Parameters:
+//   - source: The identifier of the source node.
+//   - k: The hop distance of the ring. A k of 0 gives the source itself.
+//
+// Returns a slice of node identifiers in ascending order; empty if no node is exactly k hops away
+// or the source does not exist.
+func (g *Graph) NeighborsAtDistance(source Identifier, k int) []Identifier {
+	result := []Identifier{}
+
+	if g.nodes.find(source) == nil {
+		return result
+	}
+
+	for id, depth := range g.hopDepths(source, k) {
+		if depth == k {
+			result = append(result, id)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+
+	return result
+}
+
+// Reachable returns the set of nodes reachable from a source node by following edges in their direction.
+// A single breadth-first search is cheaper than a full transitive closure when only one source matters.
+// For undirected graphs the result is the connected component of the source.
+//
+// Parameters:
+//   - source: The identifier of the source node.
+//
+// Returns a set of node identifiers, including the source itself; empty if the source does not exist.
+// Only existing nodes are included, even if edges to removed nodes remain.
+func (g *Graph) Reachable(source Identifier) map[Identifier]bool {
+	result := make(map[Identifier]bool)
+
+	if g.nodes.find(source) == nil {
+		return result
+	}
+
+	// No shortest path has more hops than there are nodes.
+	for id := range g.hopDepths(source, len(g.nodes.nodes)) {
+		result[id] = true
+	}
+
+	return result
+}
+
+// hopDepths runs a breadth-first search from a source node, stopping at a maximum depth.
+//
+// Parameters:
+//   - source: The identifier of the source node, which must exist.
+//   - radius: The maximum number of hops to explore.
+//
+// Returns a map from every node within radius hops to its hop distance from the source.
+// Edges to removed nodes, which RemoveNode leaves in the adjacency lists of their sources, are not followed.
+func (g *Graph) hopDepths(source Identifier, radius int) map[Identifier]int {
+	depth := map[Identifier]int{source: 0}
+	queue := []Identifier{source}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		if depth[node] >= radius {
+			continue
+		}
+
+		for _, e := range g.nodes.find(node).edges {
+			if g.nodes.find(e.to) == nil {
+				continue
+			}
+
+			if _, seen := depth[e.to]; !seen {
+				depth[e.to] = depth[node] + 1
+				queue = append(queue, e.to)
+			}
+		}
+	}
+
+	return depth
+}
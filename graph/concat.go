@@ -0,0 +1,70 @@
+package graph
+
+// Concat builds the disjoint union of two graphs, keeping their nodes apart even when their identifiers overlap.
+// The nodes of a keep their identifiers and the nodes of b are placed after them, shifted by a.NodeCount().
+// Node names, node weights, edge weights, and edge labels are copied.
+//
+// Parameters:
+//   - a: The first graph, whose identifiers are kept.
+//   - b: The second graph, whose identifiers are shifted.
+//
+// Returns:
+//   - The combined graph, of the type of a, made weighted if b is weighted. No edge connects the two parts.
+//   - A map from each identifier of b to its identifier in the combined graph.
+//
+// Notes:
+//   - If nodes of a were removed, its identifiers may exceed its node count; b is then shifted past the
+//     largest identifier a has ever assigned instead, so the two parts never collide.
+//   - The combined graph allows self-loops and parallel edges if either graph does.
+//   - The edges of an undirected b are added in both directions when a is directed.
+func Concat(a, b *Graph) (*Graph, map[Identifier]Identifier) {
+	graphType := a.graphType
+
+	if b.graphType == DirectedWeighted || b.graphType == UndirectedWeighted {
+		switch graphType {
+		case DirectedUnweighted:
+			graphType = DirectedWeighted
+		case UndirectedUnweighted:
+			graphType = UndirectedWeighted
+		}
+	}
+
+	result := NewGraph(graphType, len(a.nodes.nodes)+len(b.nodes.nodes))
+	result.selfLoops = a.selfLoops || b.selfLoops
+	result.multi = a.multi || b.multi
+
+	shift := max(Identifier(a.NodeCount()), a.nowID)
+	mapping := make(map[Identifier]Identifier, len(b.nodes.nodes))
+
+	for _, id := range a.sortedIDs() {
+		node := a.nodes.find(id)
+		result.insertNode(id, node.Name, node.weight)
+	}
+
+	for _, id := range b.sortedIDs() {
+		node := b.nodes.find(id)
+		mapping[id] = id + shift
+
+		result.insertNode(id+shift, node.Name, node.weight)
+	}
+
+	a.forEachEdge(func(from Identifier, e *Edge) {
+		result.AddWeightEdge(from, e.to, e.distance)
+		result.SetEdgeLabel(from, e.to, e.label)
+	})
+
+	reverse := (graphType == DirectedUnweighted || graphType == DirectedWeighted) &&
+		(b.graphType == UndirectedUnweighted || b.graphType == UndirectedWeighted)
+
+	b.forEachEdge(func(from Identifier, e *Edge) {
+		result.AddWeightEdge(mapping[from], mapping[e.to], e.distance)
+		result.SetEdgeLabel(mapping[from], mapping[e.to], e.label)
+
+		if reverse && from != e.to {
+			result.AddWeightEdge(mapping[e.to], mapping[from], e.distance)
+			result.SetEdgeLabel(mapping[e.to], mapping[from], e.label)
+		}
+	})
+
+	return result, mapping
+}
@@ -0,0 +1,80 @@
+package graph
+
+import "sort"
+
+// DegreeSequence returns the degrees of all nodes in non-increasing order.
+// For directed graphs the degree of a node is the sum of its in-degree and out-degree.
+// A self-loop adds 2 to the degree of its node in both directed and undirected graphs.
+//
+// Returns a slice of node degrees, largest first.
+func (g *Graph) DegreeSequence() []int {
+	degree := make(map[Identifier]int, len(g.nodes.nodes))
+	directed := g.graphType == DirectedUnweighted || g.graphType == DirectedWeighted
+
+	for id, node := range g.nodes.nodes {
+		degree[id] += len(node.edges)
+
+		for _, e := range node.edges {
+			// Incoming ends of directed edges, and the second end of an undirected self-loop.
+			if directed || e.to == id {
+				degree[e.to]++
+			}
+		}
+	}
+
+	result := make([]int, 0, len(degree))
+	for _, d := range degree {
+		result = append(result, d)
+	}
+
+	sort.Sort(sort.Reverse(sort.IntSlice(result)))
+
+	return result
+}
+
+// IsGraphical reports whether a degree sequence can be realized by a simple undirected graph,
+// i.e. one without self-loops or multi-edges, using the Erdős–Gallai theorem.
+//
+// Parameters:
+//   - sequence: The degree sequence in any order. It is not modified.
+//
+// Returns true if some simple graph has exactly these degrees, false otherwise.
+//
+// Notes:
+//   - A sequence d1 ≥ ... ≥ dn is graphical iff its sum is even and for every k,
+//     d1 + ... + dk ≤ k(k-1) + min(d(k+1), k) + ... + min(dn, k).
+//   - Only simple graphs are considered, matching the graphs this package builds. For example [3,1,1,1]
+//     is graphical (a star), while [2,2] is not, although it is realizable with a multi-edge.
+func IsGraphical(sequence []int) bool {
+	degrees := append([]int{}, sequence...)
+	sort.Sort(sort.Reverse(sort.IntSlice(degrees)))
+
+	sum := 0
+	for _, d := range degrees {
+		if d < 0 {
+			return false
+		}
+
+		sum += d
+	}
+
+	if sum%2 != 0 {
+		return false
+	}
+
+	prefix := 0
+	for k := 1; k <= len(degrees); k++ {
+		prefix += degrees[k-1]
+
+		bound := k * (k - 1)
+		for _, d := range degrees[k:] {
+			bound += min(d, k)
+		}
+
+		if prefix > bound {
+			return false
+		}
+	}
+
+	return true
+}
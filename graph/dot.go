@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// dotEscaper escapes the characters that would terminate a quoted DOT string.
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// ExportDOT writes the graph in the Graphviz DOT language.
+// Nodes are written by identifier with their names as labels; edges carry their weight
+// and, if set, their label.
+//
+// Parameters:
+//   - g: The graph to export.
+//   - w: The writer to write the DOT document to.
+//
+// Returns an error if writing fails.
+func ExportDOT(g *Graph, w io.Writer) error {
+	out := bufio.NewWriter(w)
+
+	keyword, arrow := "digraph", "->"
+	if g.graphType == UndirectedUnweighted || g.graphType == UndirectedWeighted {
+		keyword, arrow = "graph", "--"
+	}
+
+	fmt.Fprintf(out, "%s G {\n", keyword)
+
+	for _, id := range g.sortedIDs() {
+		fmt.Fprintf(out, "  %d [label=\"%s\"];\n", id, dotEscaper.Replace(g.nodes.find(id).Name))
+	}
+
+	g.forEachEdge(func(from Identifier, e *Edge) {
+		fmt.Fprintf(out, "  %d %s %d [weight=%d", from, arrow, e.to, e.distance)
+
+		if e.label != "" {
+			fmt.Fprintf(out, ", label=\"%s\"", dotEscaper.Replace(e.label))
+		}
+
+		fmt.Fprintf(out, "];\n")
+	})
+
+	fmt.Fprintf(out, "}\n")
+
+	return out.Flush()
+}
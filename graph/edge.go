@@ -1,10 +1,12 @@
 package graph
 
 // Edge represents a connection (edge) between two nodes in a graph.
-// It contains information about the destination node (`to`) and the weight of the edge (`distance`).
+// It contains information about the destination node (`to`), the weight of the edge (`distance`),
+// and an optional annotation (`label`).
 type Edge struct {
 	to       Identifier // The destination node's unique identifier.
 	distance Distance   // The weight or cost of traveling along this edge.
+	label    string     // An optional annotation of the edge, empty if unset.
 }
 
 // newEdge creates a new Edge instance.
@@ -31,3 +33,9 @@ func (e Edge) To() Identifier {
 func (e Edge) Distance() Distance {
 	return e.distance
 }
+
+// Label returns the annotation of the edge.
+// An empty string means no label has been set.
+func (e Edge) Label() string {
+	return e.label
+}
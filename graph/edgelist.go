@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	err "github.com/elecbug/go-graphtric/err" // Custom error package
+)
+
+// StreamEdgeList reads an edge list in CSV form and calls a handler for every edge, without building a graph.
+// Each record is "from,to" or "from,to,weight", where from and to are node labels. Only the mapping from labels
+// to identifiers is kept in memory, so arbitrarily large edge lists can be aggregated or filtered on the fly.
+//
+// Parameters:
+//   - r: The reader to read the edge list from.
+//   - directed: Whether the edges are directed. For undirected edge lists, the endpoints passed to the
+//     handler are ordered so that from <= to, which gives every undirected edge a single key.
+//   - handler: Called once per record, in file order, with the identifiers of the endpoints and the weight.
+//     A non-nil error stops the stream and is returned.
+//
+// Returns an error if a record is malformed, or the first error returned by the handler.
+//
+// Notes:
+//   - Labels are assigned identifiers from 0 in order of first appearance, as AddNode would number them.
+//   - Records without a weight have weight 1. Weights are parsed as integers and passed through unchecked,
+//     so that the handler decides how to treat negative values.
+//   - Lines starting with # are comments, and spaces after commas are trimmed. Duplicate edges are not detected.
+func StreamEdgeList(r io.Reader, directed bool, handler func(from, to Identifier, weight int64) error) error {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	ids := map[string]Identifier{}
+
+	// identify returns the identifier of a label, assigning the next one on first appearance.
+	identify := func(label string) Identifier {
+		id, ok := ids[label]
+		if !ok {
+			id = Identifier(len(ids))
+			ids[label] = id
+		}
+
+		return id
+	}
+
+	for {
+		record, e := reader.Read()
+		if e == io.EOF {
+			return nil
+		}
+		if e != nil {
+			return e
+		}
+
+		if len(record) < 2 || len(record) > 3 {
+			return err.InvalidFormat("edge list", strings.Join(record, ","))
+		}
+
+		weight := int64(1)
+		if len(record) == 3 {
+			if weight, e = strconv.ParseInt(strings.TrimSpace(record[2]), 10, 64); e != nil {
+				return err.InvalidFormat("edge list", strings.Join(record, ","))
+			}
+		}
+
+		from, to := identify(strings.TrimSpace(record[0])), identify(strings.TrimSpace(record[1]))
+		if !directed && to < from {
+			from, to = to, from
+		}
+
+		if e := handler(from, to, weight); e != nil {
+			return e
+		}
+	}
+}
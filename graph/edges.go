@@ -0,0 +1,40 @@
+package graph
+
+// WeightedEdge is an edge of a graph as listed by Edges: its endpoints and its weight.
+type WeightedEdge struct {
+	From   Identifier // Identifier of the source node.
+	To     Identifier // Identifier of the destination node.
+	Weight int64      // Weight of the edge, 1 for unweighted graphs.
+}
+
+// Edges lists every edge of the graph once, read from the adjacency matrix of ToMatrix.
+// It is the iteration primitive for algorithms that walk all edges, such as Kruskal or edge list export.
+//
+// Returns a slice of edges ordered by source and then destination identifier. For undirected graphs each edge
+// appears once, with From not greater than To.
+//
+// Notes:
+//   - Like ToMatrix, it keeps the lightest of several parallel edges, see AllowMultiEdges, and lists a self-loop
+//     as an edge from a node to itself. Use NeighborEdges to see every parallel edge and its label.
+//   - Scanning the matrix costs O(n²) regardless of the number of edges.
+func (g *Graph) Edges() []WeightedEdge {
+	matrix := g.ToMatrix()
+	directed := g.Directed()
+
+	edges := []WeightedEdge{}
+
+	for i, row := range matrix {
+		start := 0
+		if !directed {
+			start = i // The lower triangle mirrors the upper one.
+		}
+
+		for j := start; j < len(row); j++ {
+			if row[j] != INF {
+				edges = append(edges, WeightedEdge{From: Identifier(i), To: Identifier(j), Weight: int64(row[j])})
+			}
+		}
+	}
+
+	return edges
+}
@@ -0,0 +1,71 @@
+package graph
+
+// Equal reports whether two graphs have the same nodes, directedness, and edges with matching weights.
+// Node names, node weights, and edge labels are ignored; use StrictEqual to compare them as well.
+//
+// Parameters:
+//   - a: The first graph.
+//   - b: The second graph.
+//
+// Returns true if the graphs are equal, false otherwise.
+//
+// Notes:
+//   - Nodes are matched by identifier, not by isomorphism.
+//   - Only existing edges are compared, so a missing edge and an INF entry of ToMatrix are treated alike.
+func Equal(a, b *Graph) bool {
+	return equal(a, b, false)
+}
+
+// StrictEqual reports whether two graphs are equal, additionally requiring identical node names,
+// node weights, and edge labels.
+//
+// Parameters:
+//   - a: The first graph.
+//   - b: The second graph.
+//
+// Returns true if the graphs are strictly equal, false otherwise.
+func StrictEqual(a, b *Graph) bool {
+	return equal(a, b, true)
+}
+
+// equal compares two graphs, including node and edge annotations if strict is set.
+func equal(a, b *Graph, strict bool) bool {
+	if a.NodeCount() != b.NodeCount() || a.EdgeCount() != b.EdgeCount() {
+		return false
+	}
+
+	directed := func(g *Graph) bool {
+		return g.graphType == DirectedUnweighted || g.graphType == DirectedWeighted
+	}
+
+	if directed(a) != directed(b) {
+		return false
+	}
+
+	for id, na := range a.nodes.nodes {
+		nb := b.nodes.find(id)
+
+		if nb == nil || len(na.edges) != len(nb.edges) {
+			return false
+		}
+
+		if strict && (na.Name != nb.Name || na.weight != nb.weight) {
+			return false
+		}
+
+		edges := make(map[Identifier]*Edge, len(nb.edges))
+		for _, e := range nb.edges {
+			edges[e.to] = e
+		}
+
+		for _, ea := range na.edges {
+			eb, ok := edges[ea.to]
+
+			if !ok || ea.distance != eb.distance || (strict && ea.label != eb.label) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
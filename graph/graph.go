@@ -16,6 +16,8 @@ type Graph struct {
 	graphType GraphType   // The type of the graph (e.g., directed, undirected, weighted, unweighted).
 	updated   bool        // Tracks if the graph has been modified since the last update.
 	edgeCount int         // Number of edges in graph.
+	selfLoops bool        // Whether edges from a node to itself are allowed, see AllowSelfLoops.
+	multi     bool        // Whether parallel edges between the same nodes are allowed, see AllowMultiEdges.
 }
 
 // NewGraph creates and initializes a new Graph instance.
@@ -35,6 +37,65 @@ func NewGraph(graphType GraphType, capacity int) *Graph {
 	}
 }
 
+// Clone creates an independent deep copy of the graph.
+// Nodes, edges, identifiers, and the `updated` flag are copied, so modifying the clone
+// never affects the original graph or any Unit computed on it.
+//
+// Returns a pointer to the newly created Graph.
+func (g *Graph) Clone() *Graph {
+	return &Graph{
+		nodes:     g.nodes.clone(),
+		nowID:     g.nowID,
+		graphType: g.graphType,
+		updated:   g.updated,
+		edgeCount: g.edgeCount,
+		selfLoops: g.selfLoops,
+		multi:     g.multi,
+	}
+}
+
+// AllowSelfLoops sets whether edges from a node to itself may be added. Self-loops are rejected by default.
+//
+// Parameters:
+//   - allow: Whether AddEdge and AddWeightEdge accept an edge whose endpoints are the same node.
+//
+// Notes:
+//   - A self-loop appears on the diagonal of ToMatrix with its weight; a diagonal entry of INF means that
+//     the node has no self-loop. Neighbors of a node with a self-loop include the node itself.
+//   - A self-loop is a single edge even in an undirected graph, and adds 2 to the degree of its node,
+//     once for each end, in both directed and undirected graphs.
+//   - Disallowing self-loops again only affects later additions; existing self-loops are kept.
+func (g *Graph) AllowSelfLoops(allow bool) {
+	g.selfLoops = allow
+}
+
+// SelfLoopsAllowed reports whether the graph accepts self-loops, see AllowSelfLoops.
+func (g Graph) SelfLoopsAllowed() bool {
+	return g.selfLoops
+}
+
+// AllowMultiEdges switches the graph into or out of multigraph mode. In multigraph mode, adding an edge
+// between two nodes that are already connected adds a parallel edge instead of failing, e.g. to record
+// repeated transactions. Parallel edges are rejected by default.
+//
+// Parameters:
+//   - allow: Whether AddEdge and AddWeightEdge accept parallel edges.
+//
+// Notes:
+//   - Every parallel edge counts in EdgeCount, and Neighbors lists its destination once per edge.
+//     Use EdgeMultiplicity to count them and Simplify to collapse them for algorithms expecting a simple graph.
+//   - ToMatrix keeps the lightest of the parallel edges. RemoveEdge, SetEdgeWeight, and SetEdgeLabel
+//     act on the first of them.
+//   - Disallowing parallel edges again only affects later additions; existing parallel edges are kept.
+func (g *Graph) AllowMultiEdges(allow bool) {
+	g.multi = allow
+}
+
+// MultiEdgesAllowed reports whether the graph is in multigraph mode, see AllowMultiEdges.
+func (g Graph) MultiEdgesAllowed() bool {
+	return g.multi
+}
+
 // AddNode adds a new node to the graph with the given name.
 //
 // Parameters:
@@ -99,6 +160,42 @@ func (g *Graph) FindNodesByName(name string) ([]*Node, error) {
 	}
 }
 
+// SetNodeWeight sets the weight of a node, used by node-weighted algorithms such as weighted centrality or capacitated flow.
+//
+// Parameters:
+//   - identifier: The unique identifier of the node.
+//   - weight: The new weight of the node.
+//
+// Returns an error if the node does not exist.
+func (g *Graph) SetNodeWeight(identifier Identifier, weight float64) error {
+	node := g.nodes.find(identifier)
+
+	if node == nil {
+		return err.NotExistNode(identifier.String())
+	}
+
+	node.weight = weight
+	g.updated = false // Mark the graph as modified.
+
+	return nil
+}
+
+// NodeWeight returns the weight of a node.
+//
+// Parameters:
+//   - identifier: The unique identifier of the node.
+//
+// Returns the weight of the node, 1.0 unless set otherwise, or 0 if the node does not exist.
+func (g *Graph) NodeWeight(identifier Identifier) float64 {
+	node := g.nodes.find(identifier)
+
+	if node == nil {
+		return 0
+	}
+
+	return node.weight
+}
+
 // AddEdge adds an unweighted edge between two nodes in the graph.
 //
 // Parameters:
@@ -115,7 +212,7 @@ func (g *Graph) AddEdge(from, to Identifier) error {
 // Parameters:
 //   - from: The identifier of the source node.
 //   - to: The identifier of the destination node.
-//   - distance: The weight of the edge.
+//   - distance: The weight of the edge. A negative weight w is passed as Distance(int64(w)), see HasNegativeWeights.
 //
 // Returns an error if the edge cannot be added.
 func (g *Graph) AddWeightEdge(from, to Identifier, distance Distance) error {
@@ -124,7 +221,7 @@ func (g *Graph) AddWeightEdge(from, to Identifier, distance Distance) error {
 		return err.InvalidEdge(g.graphType.String(), fmt.Sprintf("weight: %d", distance))
 	}
 
-	if from == to {
+	if from == to && !g.selfLoops {
 		return err.SelfEdge(from.String())
 	}
 
@@ -136,9 +233,9 @@ func (g *Graph) AddWeightEdge(from, to Identifier, distance Distance) error {
 		return err.NotExistNode(to.String())
 	}
 
-	// Prevent duplicate edges.
+	// Prevent duplicate edges unless the graph is a multigraph.
 	for _, e := range g.nodes.find(from).Edges() {
-		if e.To() == to {
+		if e.To() == to && !g.multi {
 			return err.AlreadyEdge(from.String(), to.String())
 		}
 	}
@@ -146,8 +243,8 @@ func (g *Graph) AddWeightEdge(from, to Identifier, distance Distance) error {
 	// Add the edge to the source node.
 	g.nodes.find(from).addEdge(to, distance)
 
-	// Add a reverse edge for undirected graphs.
-	if g.graphType == UndirectedUnweighted || g.graphType == UndirectedWeighted {
+	// Add a reverse edge for undirected graphs; a self-loop is stored once.
+	if (g.graphType == UndirectedUnweighted || g.graphType == UndirectedWeighted) && from != to {
 		g.nodes.find(to).addEdge(from, distance)
 	}
 
@@ -157,8 +254,131 @@ func (g *Graph) AddWeightEdge(from, to Identifier, distance Distance) error {
 	return nil
 }
 
+// RemoveEdge removes an edge between two nodes in the graph.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//
+// Returns an error if the nodes or the edge do not exist.
+func (g *Graph) RemoveEdge(from, to Identifier) error {
+	// Ensure both nodes exist in the graph.
+	if g.nodes.find(from) == nil {
+		return err.NotExistNode(from.String())
+	}
+	if g.nodes.find(to) == nil {
+		return err.NotExistNode(to.String())
+	}
+
+	if !g.nodes.find(from).removeEdge(to) {
+		return err.NotExistEdge(from.String(), to.String())
+	}
+
+	// Remove the reverse edge for undirected graphs.
+	if (g.graphType == UndirectedUnweighted || g.graphType == UndirectedWeighted) && from != to {
+		g.nodes.find(to).removeEdge(from)
+	}
+
+	g.updated = false // Mark the graph as modified.
+	g.edgeCount--     // Update edge count
+
+	return nil
+}
+
+// SetEdgeWeight changes the weight of an existing edge in the graph.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - distance: The new weight of the edge.
+//
+// Returns an error if the nodes or the edge do not exist, or if the weight does not fit the graph type.
+func (g *Graph) SetEdgeWeight(from, to Identifier, distance Distance) error {
+	if (g.graphType == DirectedUnweighted || g.graphType == UndirectedUnweighted) && distance != 1 {
+		return err.InvalidEdge(g.graphType.String(), fmt.Sprintf("weight: %d", distance))
+	}
+
+	// Ensure both nodes exist in the graph.
+	if g.nodes.find(from) == nil {
+		return err.NotExistNode(from.String())
+	}
+	if g.nodes.find(to) == nil {
+		return err.NotExistNode(to.String())
+	}
+
+	if !g.nodes.find(from).setEdge(to, distance) {
+		return err.NotExistEdge(from.String(), to.String())
+	}
+
+	// Update the reverse edge for undirected graphs.
+	if g.graphType == UndirectedUnweighted || g.graphType == UndirectedWeighted {
+		g.nodes.find(to).setEdge(from, distance)
+	}
+
+	g.updated = false // Mark the graph as modified.
+
+	return nil
+}
+
+// SetEdgeLabel attaches a string label to an existing edge.
+// Labels are stored with the edge, so removing the edge also removes its label.
+// For undirected graphs both directions of the edge share the label.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//   - label: The label of the edge; an empty string clears it.
+//
+// Returns an error if the nodes or the edge do not exist.
+func (g *Graph) SetEdgeLabel(from, to Identifier, label string) error {
+	// Ensure both nodes exist in the graph.
+	if g.nodes.find(from) == nil {
+		return err.NotExistNode(from.String())
+	}
+	if g.nodes.find(to) == nil {
+		return err.NotExistNode(to.String())
+	}
+
+	if !g.nodes.find(from).setLabel(to, label) {
+		return err.NotExistEdge(from.String(), to.String())
+	}
+
+	// Label the reverse edge for undirected graphs.
+	if g.graphType == UndirectedUnweighted || g.graphType == UndirectedWeighted {
+		g.nodes.find(to).setLabel(from, label)
+	}
+
+	return nil
+}
+
+// EdgeLabel returns the label of an edge.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//
+// Returns the label of the edge, or an empty string if the edge does not exist or has no label.
+func (g *Graph) EdgeLabel(from, to Identifier) string {
+	node := g.nodes.find(from)
+
+	if node == nil {
+		return ""
+	}
+
+	for _, e := range node.edges {
+		if e.to == to {
+			return e.label
+		}
+	}
+
+	return ""
+}
+
 // ToMatrix converts the graph to an adjacency matrix representation.
 // Returns a Matrix where each element represents the distance between two nodes.
+// Missing edges are INF, including the diagonal: entry [i][i] holds the weight of a self-loop on node i
+// if there is one, see AllowSelfLoops, and INF otherwise. Of several parallel edges, see AllowMultiEdges,
+// the one with the smallest distance is kept.
 func (g *Graph) ToMatrix() Matrix {
 	size := g.nowID
 	matrix := make([][]Distance, size)
@@ -174,13 +394,52 @@ func (g *Graph) ToMatrix() Matrix {
 	// Populate the matrix with edge distances.
 	for from_id, from := range g.nodes.nodes {
 		for _, from_edge := range from.Edges() {
-			matrix[from_id][from_edge.To()] = from_edge.Distance()
+			matrix[from_id][from_edge.To()] = min(matrix[from_id][from_edge.To()], from_edge.Distance())
 		}
 	}
 
 	return matrix
 }
 
+// Neighbors returns the identifiers of the nodes directly reachable from the given node.
+// It reads the node's adjacency list, so it costs O(degree) instead of the O(n²) of ToMatrix.
+//
+// Parameters:
+//   - identifier: The unique identifier of the node.
+//
+// Returns a slice of neighbor identifiers, or nil if the node does not exist.
+func (g *Graph) Neighbors(identifier Identifier) []Identifier {
+	node := g.nodes.find(identifier)
+
+	if node == nil {
+		return nil
+	}
+
+	result := make([]Identifier, 0, len(node.edges))
+	for _, e := range node.edges {
+		result = append(result, e.to)
+	}
+
+	return result
+}
+
+// NeighborEdges returns the edges leaving the given node, including their weights.
+// It is the weighted counterpart of Neighbors.
+//
+// Parameters:
+//   - identifier: The unique identifier of the node.
+//
+// Returns a slice of edges, or nil if the node does not exist.
+func (g *Graph) NeighborEdges(identifier Identifier) []Edge {
+	node := g.nodes.find(identifier)
+
+	if node == nil {
+		return nil
+	}
+
+	return node.Edges()
+}
+
 // NodeCount returns the number of nodes in the graph.
 func (g Graph) NodeCount() int {
 	return len(g.nodes.nodes)
@@ -191,11 +450,35 @@ func (g Graph) EdgeCount() int {
 	return g.edgeCount
 }
 
+// HasNegativeWeights reports whether any edge of the graph has a negative weight.
+// Distance is unsigned, so a negative weight is one converted from a negative int64, which reads as negative
+// again when converted back, as BellmanFord, Johnson, and the other int64-based algorithms do.
+// Any weight above math.MaxInt64 therefore counts as negative, including INF, which has the bits of -1.
+// Dijkstra-based shortest paths are wrong on such graphs.
+//
+// Returns true if at least one edge has a negative weight.
+func (g *Graph) HasNegativeWeights() bool {
+	for _, node := range g.nodes.nodes {
+		for _, e := range node.edges {
+			if int64(e.distance) < 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // Type returns the type of the graph (e.g., directed/undirected, weighted/unweighted).
 func (g Graph) Type() GraphType {
 	return g.graphType
 }
 
+// Directed reports whether the graph is directed, i.e. of type DirectedUnweighted or DirectedWeighted.
+func (g Graph) Directed() bool {
+	return g.graphType == DirectedUnweighted || g.graphType == DirectedWeighted
+}
+
 // Updated returns whether the graph has been updated since the last algorithmic computation.
 func (g Graph) Updated() bool {
 	return g.updated
@@ -0,0 +1,235 @@
+package graph
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+
+	err "github.com/elecbug/go-graphtric/err" // Custom error package
+)
+
+// graphmlDocument is the root element of a GraphML document.
+type graphmlDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphmlKey `xml:"key"`
+	Graph   graphmlGraph `xml:"graph"`
+}
+
+// graphmlKey declares a data attribute of graphs, nodes, or edges.
+type graphmlKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+// graphmlGraph holds the nodes and edges of a GraphML document.
+type graphmlGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Data        []graphmlData `xml:"data"`
+	Nodes       []graphmlNode `xml:"node"`
+	Edges       []graphmlEdge `xml:"edge"`
+}
+
+// graphmlNode is a node element of a GraphML document.
+type graphmlNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphmlData `xml:"data"`
+}
+
+// graphmlEdge is an edge element of a GraphML document.
+type graphmlEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphmlData `xml:"data"`
+}
+
+// graphmlData is a data value attached to a graph, node, or edge.
+type graphmlData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// ExportGraphML writes the graph as a GraphML document.
+// The graph type, node names and weights, and edge weights and labels are written as data attributes,
+// so ImportGraphML restores the same graph.
+//
+// Parameters:
+//   - g: The graph to export.
+//   - w: The writer to write the GraphML document to.
+//
+// Returns an error if encoding or writing fails.
+func ExportGraphML(g *Graph, w io.Writer) error {
+	edgeDefault := "directed"
+	if g.graphType == UndirectedUnweighted || g.graphType == UndirectedWeighted {
+		edgeDefault = "undirected"
+	}
+
+	doc := graphmlDocument{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphmlKey{
+			{ID: "type", For: "graph", Name: "type", Type: "int"},
+			{ID: "name", For: "node", Name: "name", Type: "string"},
+			{ID: "weight", For: "node", Name: "weight", Type: "double"},
+			{ID: "distance", For: "edge", Name: "distance", Type: "long"},
+			{ID: "label", For: "edge", Name: "label", Type: "string"},
+		},
+		Graph: graphmlGraph{
+			ID:          "G",
+			EdgeDefault: edgeDefault,
+			Data:        []graphmlData{{Key: "type", Value: strconv.Itoa(int(g.graphType))}},
+		},
+	}
+
+	for _, id := range g.sortedIDs() {
+		node := g.nodes.find(id)
+
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphmlNode{
+			ID: fmt.Sprintf("n%d", id),
+			Data: []graphmlData{
+				{Key: "name", Value: node.Name},
+				{Key: "weight", Value: strconv.FormatFloat(node.weight, 'g', -1, 64)},
+			},
+		})
+	}
+
+	g.forEachEdge(func(from Identifier, e *Edge) {
+		edge := graphmlEdge{
+			Source: fmt.Sprintf("n%d", from),
+			Target: fmt.Sprintf("n%d", e.to),
+			Data:   []graphmlData{{Key: "distance", Value: strconv.FormatUint(uint64(e.distance), 10)}},
+		}
+
+		if e.label != "" {
+			edge.Data = append(edge.Data, graphmlData{Key: "label", Value: e.label})
+		}
+
+		doc.Graph.Edges = append(doc.Graph.Edges, edge)
+	})
+
+	if _, e := io.WriteString(w, xml.Header); e != nil {
+		return e
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	return encoder.Encode(doc)
+}
+
+// ImportGraphML reads a GraphML document written by ExportGraphML.
+// Node identifiers of the form "n<number>" are preserved; other node identifiers are numbered in document order
+// after the largest preserved identifier. Documents without a graph type are read as weighted graphs
+// whose directedness follows the edgedefault attribute.
+//
+// Parameters:
+//   - r: The reader to read the GraphML document from.
+//
+// Returns the decoded graph and an error if the document is malformed or describes invalid nodes or edges.
+func ImportGraphML(r io.Reader) (*Graph, error) {
+	var doc graphmlDocument
+
+	if e := xml.NewDecoder(r).Decode(&doc); e != nil {
+		return nil, e
+	}
+
+	graphType := DirectedWeighted
+	if doc.Graph.EdgeDefault == "undirected" {
+		graphType = UndirectedWeighted
+	}
+
+	for _, d := range doc.Graph.Data {
+		if d.Key == "type" {
+			value, e := strconv.Atoi(d.Value)
+			if e != nil {
+				return nil, e
+			}
+
+			graphType = GraphType(value)
+		}
+	}
+
+	result := NewGraph(graphType, len(doc.Graph.Nodes))
+	ids := make(map[string]Identifier, len(doc.Graph.Nodes))
+
+	for _, n := range doc.Graph.Nodes {
+		var id Identifier
+		if _, e := fmt.Sscanf(n.ID, "n%d", &id); e == nil && fmt.Sprintf("n%d", id) == n.ID {
+			ids[n.ID] = id
+		}
+	}
+
+	next := result.nowID
+	for _, id := range ids {
+		if id >= next {
+			next = id + 1
+		}
+	}
+
+	for _, n := range doc.Graph.Nodes {
+		id, ok := ids[n.ID]
+		if !ok {
+			id, next = next, next+1
+			ids[n.ID] = id
+		}
+
+		name, weight := n.ID, 1.0
+
+		for _, d := range n.Data {
+			switch d.Key {
+			case "name":
+				name = d.Value
+			case "weight":
+				value, e := strconv.ParseFloat(d.Value, 64)
+				if e != nil {
+					return nil, e
+				}
+
+				weight = value
+			}
+		}
+
+		if e := result.insertNode(id, name, weight); e != nil {
+			return nil, e
+		}
+	}
+
+	for _, edge := range doc.Graph.Edges {
+		distance, label := Distance(1), ""
+
+		for _, d := range edge.Data {
+			switch d.Key {
+			case "distance":
+				value, e := strconv.ParseUint(d.Value, 10, 64)
+				if e != nil {
+					return nil, e
+				}
+
+				distance = Distance(value)
+			case "label":
+				label = d.Value
+			}
+		}
+
+		from, ok := ids[edge.Source]
+		if !ok {
+			return nil, err.NotExistNode(edge.Source)
+		}
+
+		to, ok := ids[edge.Target]
+		if !ok {
+			return nil, err.NotExistNode(edge.Target)
+		}
+
+		if e := result.AddWeightEdge(from, to, distance); e != nil {
+			return nil, e
+		}
+
+		result.SetEdgeLabel(from, to, label)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// graphJSON is the serialized form of a Graph.
+type graphJSON struct {
+	Type       GraphType  `json:"type"`                  // The type of the graph.
+	SelfLoops  bool       `json:"self_loops,omitempty"`  // Whether self-loops are allowed, omitted if not.
+	MultiEdges bool       `json:"multi_edges,omitempty"` // Whether parallel edges are allowed, omitted if not.
+	Nodes      []nodeJSON `json:"nodes"`                 // The nodes of the graph in ascending identifier order.
+	Edges      []edgeJSON `json:"edges"`                 // The edges of the graph; undirected edges appear once.
+}
+
+// nodeJSON is the serialized form of a Node.
+type nodeJSON struct {
+	ID     Identifier `json:"id"`     // Unique identifier of the node.
+	Name   string     `json:"name"`   // Display name of the node.
+	Weight float64    `json:"weight"` // Weight of the node.
+}
+
+// edgeJSON is the serialized form of an Edge.
+type edgeJSON struct {
+	From     Identifier `json:"from"`            // Identifier of the source node.
+	To       Identifier `json:"to"`              // Identifier of the destination node.
+	Distance Distance   `json:"distance"`        // Weight of the edge.
+	Label    string     `json:"label,omitempty"` // Label of the edge, omitted if unset.
+}
+
+// MarshalJSON encodes the graph as JSON, including its type, node names and weights, and edge weights.
+// It implements json.Marshaler.
+//
+// Returns the encoded graph and an error if encoding fails.
+func (g *Graph) MarshalJSON() ([]byte, error) {
+	data := graphJSON{Type: g.graphType, SelfLoops: g.selfLoops, MultiEdges: g.multi, Nodes: []nodeJSON{}, Edges: []edgeJSON{}}
+
+	for _, id := range g.sortedIDs() {
+		node := g.nodes.find(id)
+		data.Nodes = append(data.Nodes, nodeJSON{ID: id, Name: node.Name, Weight: node.weight})
+	}
+
+	g.forEachEdge(func(from Identifier, e *Edge) {
+		data.Edges = append(data.Edges, edgeJSON{From: from, To: e.to, Distance: e.distance, Label: e.label})
+	})
+
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON decodes a graph encoded by MarshalJSON, replacing the contents of g.
+// Node identifiers are preserved. It implements json.Unmarshaler.
+//
+// Parameters:
+//   - data: The encoded graph.
+//
+// Returns an error if the data is malformed or describes invalid nodes or edges.
+func (g *Graph) UnmarshalJSON(data []byte) error {
+	var decoded graphJSON
+
+	if e := json.Unmarshal(data, &decoded); e != nil {
+		return e
+	}
+
+	result := NewGraph(decoded.Type, len(decoded.Nodes))
+	result.selfLoops = decoded.SelfLoops
+	result.multi = decoded.MultiEdges
+
+	for _, n := range decoded.Nodes {
+		if e := result.insertNode(n.ID, n.Name, n.Weight); e != nil {
+			return e
+		}
+	}
+
+	for _, edge := range decoded.Edges {
+		if e := result.AddWeightEdge(edge.From, edge.To, edge.Distance); e != nil {
+			return e
+		}
+
+		result.SetEdgeLabel(edge.From, edge.To, edge.Label)
+	}
+
+	*g = *result
+
+	return nil
+}
+
+// insertNode adds a node with a given identifier, keeping `nowID` ahead of every identifier in use.
+// It is used when decoding graphs whose identifiers must be preserved.
+//
+// Parameters:
+//   - identifier: The unique identifier of the node.
+//   - name: The display name for the node.
+//   - weight: The weight of the node.
+//
+// Returns an error if a node with the same identifier already exists.
+func (g *Graph) insertNode(identifier Identifier, name string, weight float64) error {
+	node := newNode(identifier, name)
+	node.weight = weight
+
+	if e := g.nodes.insert(node); e != nil {
+		return e
+	}
+
+	if identifier >= g.nowID {
+		g.nowID = identifier + 1
+	}
+
+	return nil
+}
+
+// sortedIDs returns the node identifiers of the graph in ascending order.
+func (g *Graph) sortedIDs() []Identifier {
+	ids := g.NodeIDs()
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
+
+// forEachEdge calls fn for every edge in ascending order of the source identifier.
+// Undirected edges are stored in both directions but visited once, from the smaller identifier.
+//
+// Parameters:
+//   - fn: The function called with the source identifier and the edge.
+func (g *Graph) forEachEdge(fn func(from Identifier, e *Edge)) {
+	undirected := g.graphType == UndirectedUnweighted || g.graphType == UndirectedWeighted
+
+	for _, id := range g.sortedIDs() {
+		for _, e := range g.nodes.find(id).edges {
+			if undirected && e.to < id {
+				continue
+			}
+
+			fn(id, e)
+		}
+	}
+}
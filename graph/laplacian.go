@@ -0,0 +1,66 @@
+package graph
+
+import "math"
+
+// LaplacianMatrix returns the Laplacian matrix L = D - A of the graph.
+// A is the weighted adjacency matrix read from ToMatrix, with INF entries treated as no edge,
+// and D is the diagonal matrix of the row sums of A (the out-strength of each node).
+//
+// Returns a matrix indexed by node identifier, like ToMatrix; rows and columns of removed nodes are zero.
+//
+// Notes:
+//   - Every row of L sums to zero. For undirected graphs L is symmetric and positive semi-definite.
+//   - For directed graphs D holds out-strengths, so this is the out-degree Laplacian.
+func (g *Graph) LaplacianMatrix() [][]float64 {
+	matrix := g.ToMatrix()
+	laplacian := make([][]float64, len(matrix))
+
+	for i, row := range matrix {
+		laplacian[i] = make([]float64, len(row))
+
+		for j, value := range row {
+			if i == j || value == INF {
+				continue
+			}
+
+			laplacian[i][j] = -float64(value)
+			laplacian[i][i] += float64(value)
+		}
+	}
+
+	return laplacian
+}
+
+// NormalizedLaplacian returns the symmetric normalized Laplacian I - D^{-1/2} A D^{-1/2} of the graph,
+// where A and D are defined as in LaplacianMatrix.
+//
+// Returns a matrix indexed by node identifier, like ToMatrix; rows and columns of isolated or removed nodes are zero.
+//
+// Notes:
+//   - For undirected graphs the eigenvalues lie in [0, 2], and the multiplicity of 0 equals the number
+//     of connected components that contain an edge.
+//   - For directed graphs D holds out-strengths, so entries toward nodes without outgoing edges are zero.
+func (g *Graph) NormalizedLaplacian() [][]float64 {
+	laplacian := g.LaplacianMatrix()
+
+	degree := make([]float64, len(laplacian))
+	for i := range laplacian {
+		degree[i] = laplacian[i][i]
+	}
+
+	// Divide each entry by the square roots of the degrees of its row and column.
+	for i, row := range laplacian {
+		for j := range row {
+			switch {
+			case degree[i] == 0 || degree[j] == 0:
+				row[j] = 0
+			case i == j:
+				row[j] = 1
+			default:
+				row[j] /= math.Sqrt(degree[i] * degree[j])
+			}
+		}
+	}
+
+	return laplacian
+}
@@ -2,6 +2,8 @@ package graph
 
 import (
 	"fmt"
+
+	err "github.com/elecbug/go-graphtric/err" // Custom error package
 )
 
 // Matrix represents the adjacency matrix of a graph.
@@ -35,3 +37,107 @@ func (m Matrix) String() string {
 
 	return result
 }
+
+// NoEdge is the entry of an int64 weight matrix that marks a missing edge, see NewGraphFromMatrix.
+const NoEdge int64 = -1
+
+// Int64 converts the matrix to int64 weights, writing NoEdge for every INF entry.
+// The result can be passed to NewGraphFromMatrix to rebuild the graph.
+func (m Matrix) Int64() [][]int64 {
+	result := make([][]int64, len(m))
+
+	for i, row := range m {
+		result[i] = make([]int64, len(row))
+
+		for j, value := range row {
+			if value == INF {
+				result[i][j] = NoEdge
+			} else {
+				result[i][j] = int64(value)
+			}
+		}
+	}
+
+	return result
+}
+
+// NewGraphFromMatrix builds a graph from a square weight matrix, the inverse of ToMatrix.
+// Node i is created for row i, and every entry [i][j] other than NoEdge becomes an edge from i to j with that weight.
+//
+// Parameters:
+//   - matrix: The weight matrix. Entries equal to NoEdge mean that there is no edge.
+//   - directed: Whether the graph is directed. Undirected graphs require a symmetric matrix.
+//
+// Returns the new graph and an error if the matrix is not square, not symmetric although undirected,
+// or contains a negative weight.
+//
+// Notes:
+//   - The graph is weighted if any weight differs from 1, and unweighted otherwise.
+//   - Nodes are named after their identifiers.
+//   - A positive diagonal entry [i][i] becomes a self-loop on node i, and self-loops are then allowed in the graph,
+//     see AllowSelfLoops, so the ToMatrix of a graph with self-loops round-trips. A diagonal entry of 0 means
+//     no self-loop, as in a plain adjacency matrix.
+//   - Rows of removed nodes in a ToMatrix result have no edges and come back as isolated nodes.
+func NewGraphFromMatrix(matrix [][]int64, directed bool) (*Graph, error) {
+	n := len(matrix)
+	weighted, loops := false, false
+
+	for i, row := range matrix {
+		if len(row) != n {
+			return nil, err.InvalidArgument("matrix", fmt.Sprintf("row %d has %d entries, want %d", i, len(row), n))
+		}
+	}
+
+	for i, row := range matrix {
+		for j, value := range row {
+			switch {
+			case value == NoEdge:
+				continue
+			case value < 0:
+				return nil, err.InvalidArgument("matrix", fmt.Sprintf("negative weight %d at [%d][%d]", value, i, j))
+			case i == j && value == 0:
+				continue
+			case i == j:
+				loops = true
+			case !directed && matrix[j][i] != value:
+				return nil, err.InvalidArgument("matrix", fmt.Sprintf("asymmetric entries at [%d][%d] and [%d][%d]", i, j, j, i))
+			}
+
+			if value != 1 {
+				weighted = true
+			}
+		}
+	}
+
+	graphType := UndirectedUnweighted
+	switch {
+	case directed && weighted:
+		graphType = DirectedWeighted
+	case directed:
+		graphType = DirectedUnweighted
+	case weighted:
+		graphType = UndirectedWeighted
+	}
+
+	g := NewGraph(graphType, n)
+	g.AllowSelfLoops(loops)
+
+	for i := 0; i < n; i++ {
+		g.AddNode(fmt.Sprint(i))
+	}
+
+	for i, row := range matrix {
+		for j, value := range row {
+			// Undirected edges are added once, from the smaller identifier.
+			if value == NoEdge || (i == j && value == 0) || (!directed && j < i) {
+				continue
+			}
+
+			if e := g.AddWeightEdge(Identifier(i), Identifier(j), Distance(value)); e != nil {
+				return nil, e
+			}
+		}
+	}
+
+	return g, nil
+}
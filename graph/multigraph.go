@@ -0,0 +1,94 @@
+package graph
+
+// EdgeMultiplicity counts the parallel edges from one node to another, see AllowMultiEdges.
+//
+// Parameters:
+//   - from: The identifier of the source node.
+//   - to: The identifier of the destination node.
+//
+// Returns the number of edges from from to to: at most 1 in a simple graph, and 0 if either node does not exist.
+func (g *Graph) EdgeMultiplicity(from, to Identifier) int {
+	node := g.nodes.find(from)
+
+	if node == nil {
+		return 0
+	}
+
+	count := 0
+	for _, e := range node.edges {
+		if e.to == to {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Simplify collapses every group of parallel edges into a single edge that keeps the smallest distance,
+// matching the entries of ToMatrix, for algorithms that need a simple graph.
+//
+// Returns a new graph of the same type with the same nodes and identifiers, outside multigraph mode.
+//
+// Notes:
+//   - The collapsed edge keeps the label of the first parallel edge. Self-loops are kept.
+func (g *Graph) Simplify() *Graph {
+	return g.simplify(g.graphType, func(a, b Distance) Distance { return min(a, b) })
+}
+
+// SimplifySum collapses every group of parallel edges into a single edge whose distance is the sum of theirs,
+// e.g. to turn repeated transactions into a total volume.
+//
+// Returns a new graph with the same nodes and identifiers, outside multigraph mode. Unweighted graphs become
+// their weighted counterparts, so that each edge carries its multiplicity as its weight.
+//
+// Notes:
+//   - The collapsed edge keeps the label of the first parallel edge. Self-loops are kept.
+func (g *Graph) SimplifySum() *Graph {
+	graphType := g.graphType
+
+	switch graphType {
+	case DirectedUnweighted:
+		graphType = DirectedWeighted
+	case UndirectedUnweighted:
+		graphType = UndirectedWeighted
+	}
+
+	return g.simplify(graphType, func(a, b Distance) Distance { return a + b })
+}
+
+// simplify builds a copy of the graph of the given type, merging the distances of parallel edges with merge.
+func (g *Graph) simplify(graphType GraphType, merge func(a, b Distance) Distance) *Graph {
+	result := NewGraph(graphType, len(g.nodes.nodes))
+	result.selfLoops = g.selfLoops
+
+	for _, id := range g.sortedIDs() {
+		node := g.nodes.find(id)
+		result.insertNode(id, node.Name, node.weight)
+	}
+
+	result.nowID = g.nowID
+
+	// Merge parallel edges, remembering the order in which the node pairs first appear.
+	merged := make(map[[2]Identifier]*Edge)
+	order := [][2]Identifier{}
+
+	g.forEachEdge(func(from Identifier, e *Edge) {
+		key := [2]Identifier{from, e.to}
+
+		if edge, exists := merged[key]; exists {
+			edge.distance = merge(edge.distance, e.distance)
+			return
+		}
+
+		merged[key] = &Edge{to: e.to, distance: e.distance, label: e.label}
+		order = append(order, key)
+	})
+
+	for _, key := range order {
+		edge := merged[key]
+		result.AddWeightEdge(key[0], key[1], edge.distance)
+		result.SetEdgeLabel(key[0], key[1], edge.label)
+	}
+
+	return result
+}
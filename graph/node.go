@@ -2,12 +2,14 @@ package graph
 
 // Node represents a node in the graph.
 // It contains a unique identifier (`identifier`), a display name (`Name`),
-// the edges connected to the node (`edges`), and a flag (`alive`) indicating whether the node is active.
+// the edges connected to the node (`edges`), a flag (`alive`) indicating whether the node is active,
+// and a weight (`weight`) used by node-weighted algorithms.
 type Node struct {
 	identifier Identifier // Unique identifier for the node.
 	Name       string     // A human-readable name for the node, which can be duplicated across nodes.
 	edges      []*Edge    // A list of edges originating from this node.
 	alive      bool       // A flag indicating whether the node is active (true) or inactive (false).
+	weight     float64    // The weight of the node, 1.0 unless set otherwise.
 }
 
 // newNode creates a new Node instance.
@@ -22,6 +24,7 @@ func newNode(identifier Identifier, name string) *Node {
 		Name:       name,
 		edges:      make([]*Edge, 0), // Initialize the edges list as empty.
 		alive:      false,            // Default to an inactive state.
+		weight:     1.0,              // Default to a unit weight.
 	}
 }
 
@@ -34,16 +37,66 @@ func (n *Node) addEdge(to Identifier, distance Distance) {
 	n.edges = append(n.edges, newEdge(to, distance))
 }
 
+// setEdge updates the weight of the edge to the given destination.
+//
+// Parameters:
+//   - to: The identifier of the destination node.
+//   - distance: The new weight of the edge.
+// Returns true if the edge exists and was updated.
+func (n *Node) setEdge(to Identifier, distance Distance) bool {
+	for _, e := range n.edges {
+		if e.to == to {
+			e.distance = distance
+			return true
+		}
+	}
+
+	return false
+}
+
+// setLabel updates the label of the edge to the given destination.
+//
+// Parameters:
+//   - to: The identifier of the destination node.
+//   - label: The new label of the edge.
+// Returns true if the edge exists and was updated.
+func (n *Node) setLabel(to Identifier, label string) bool {
+	for _, e := range n.edges {
+		if e.to == to {
+			e.label = label
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeEdge removes the edge to the given destination from the node's list of edges.
+//
+// Parameters:
+//   - to: The identifier of the destination node.
+// Returns true if the edge existed and was removed.
+func (n *Node) removeEdge(to Identifier) bool {
+	for i, e := range n.edges {
+		if e.to == to {
+			n.edges = append(n.edges[:i], n.edges[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
 // ID returns the unique identifier of the node.
 // Useful for accessing or comparing nodes by their identifiers.
 func (n Node) ID() Identifier {
 	return n.identifier
 }
 
-// Edges returns a slice of all edges connected to this node.
+// Edges returns a slice of all edges connected to this node, one entry per edge.
 // The returned edges are copied from the internal structure to avoid direct modification.
 func (n Node) Edges() []Edge {
-	result := make([]Edge, len(n.edges)) // Pre-allocate the slice to avoid overhead.
+	result := make([]Edge, 0, len(n.edges)) // Pre-allocate the capacity to avoid overhead.
 
 	for _, e := range n.edges {
 		result = append(result, *e) // Dereference the pointer to copy the edge.
@@ -52,6 +105,12 @@ func (n Node) Edges() []Edge {
 	return result
 }
 
+// Weight returns the weight of the node.
+// Nodes are created with a weight of 1.0.
+func (n Node) Weight() float64 {
+	return n.weight
+}
+
 // Up activates the node, marking it as alive.
 func (n *Node) Up() {
 	n.alive = true
@@ -26,6 +26,32 @@ func newNodes(cap int) *graphNodes {
 	}
 }
 
+// clone creates a deep copy of the graphNodes collection.
+// Every Node and its edges are copied, so the result shares no state with the original.
+//
+// Returns a pointer to the copied graphNodes instance.
+func (ns *graphNodes) clone() *graphNodes {
+	result := newNodes(len(ns.nodes))
+
+	for id, node := range ns.nodes {
+		copied := newNode(node.identifier, node.Name)
+		copied.alive = node.alive
+		copied.weight = node.weight
+
+		for _, e := range node.edges {
+			copied.edges = append(copied.edges, &Edge{to: e.to, distance: e.distance, label: e.label})
+		}
+
+		result.nodes[id] = copied
+	}
+
+	for name, ids := range ns.nameMap {
+		result.nameMap[name] = append([]Identifier{}, ids...)
+	}
+
+	return result
+}
+
 // insert adds a new Node to the graphNodes collection.
 //
 // Parameters:
@@ -0,0 +1,239 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	err "github.com/elecbug/go-graphtric/err" // Custom error package
+)
+
+// ExportPajek writes the graph in the Pajek .net format.
+// A *Vertices section lists the nodes with their names, followed by an *Arcs section for directed graphs
+// or an *Edges section for undirected graphs. Weighted graphs write the weight of each edge as a third column.
+//
+// Parameters:
+//   - g: The graph to export.
+//   - w: The writer to write the Pajek document to.
+//
+// Returns an error if writing fails.
+//
+// Notes:
+//   - Pajek numbers vertices from 1, so nodes are numbered by ascending identifier starting at 1.
+//     Identifiers are preserved by ImportPajek only if they are contiguous from 0.
+//   - Pajek has no escape sequences, so double quotes in node names are written as single quotes.
+func ExportPajek(g *Graph, w io.Writer) error {
+	out := bufio.NewWriter(w)
+	ids := g.sortedIDs()
+	number := make(map[Identifier]int, len(ids))
+
+	fmt.Fprintf(out, "*Vertices %d\n", len(ids))
+
+	for i, id := range ids {
+		number[id] = i + 1
+		fmt.Fprintf(out, "%d \"%s\"\n", i+1, strings.ReplaceAll(g.nodes.find(id).Name, `"`, `'`))
+	}
+
+	section := "*Arcs"
+	if g.graphType == UndirectedUnweighted || g.graphType == UndirectedWeighted {
+		section = "*Edges"
+	}
+
+	fmt.Fprintf(out, "%s\n", section)
+
+	weighted := g.graphType == DirectedWeighted || g.graphType == UndirectedWeighted
+
+	g.forEachEdge(func(from Identifier, e *Edge) {
+		if weighted {
+			fmt.Fprintf(out, "%d %d %d\n", number[from], number[e.to], e.distance)
+		} else {
+			fmt.Fprintf(out, "%d %d\n", number[from], number[e.to])
+		}
+	})
+
+	return out.Flush()
+}
+
+// pajekLink is an edge or arc read from a Pajek document.
+type pajekLink struct {
+	from, to Identifier
+	distance Distance
+	arc      bool
+}
+
+// ImportPajek reads a graph in the Pajek .net format.
+// Vertex k is mapped to the identifier k-1. Lines of an *Arcs section become directed edges and lines of
+// an *Edges section undirected edges; an optional trailing numeric column is the edge weight.
+//
+// Parameters:
+//   - r: The reader to read the Pajek document from.
+//
+// Returns the decoded graph and an error if the document is malformed or describes invalid nodes or edges.
+//
+// Notes:
+//   - The graph is directed if the document has an *Arcs section, and weighted if any edge has a weight column.
+//     In a directed graph, the lines of an *Edges section are added in both directions.
+//   - Vertices declared by *Vertices but not listed are named after their number. Weights must be non-negative integers.
+//   - Lines starting with % are comments; other sections, such as *Network, are skipped.
+func ImportPajek(r io.Reader) (*Graph, error) {
+	scanner := bufio.NewScanner(r)
+	names := map[int]string{}
+	links := []pajekLink{}
+	count, section := 0, ""
+	directed, weighted := false, false
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if line == "" || strings.HasPrefix(line, "%") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "*") {
+			fields := strings.Fields(line)
+			section = strings.ToLower(fields[0])
+
+			switch section {
+			case "*vertices":
+				if len(fields) < 2 {
+					return nil, err.InvalidFormat("pajek", line)
+				}
+
+				value, e := strconv.Atoi(fields[1])
+				if e != nil {
+					return nil, e
+				}
+
+				if value < 0 {
+					return nil, err.InvalidFormat("pajek", line)
+				}
+
+				count = value
+			case "*arcs":
+				directed = true
+			}
+
+			continue
+		}
+
+		switch section {
+		case "*vertices":
+			number, name, e := parsePajekVertex(line)
+			if e != nil {
+				return nil, e
+			}
+
+			if number < 1 || number > count {
+				return nil, err.NotExistNode(strconv.Itoa(number))
+			}
+
+			names[number] = name
+		case "*arcs", "*edges":
+			fields := strings.Fields(line)
+			if len(fields) < 2 {
+				return nil, err.InvalidFormat("pajek", line)
+			}
+
+			link := pajekLink{distance: 1, arc: section == "*arcs"}
+
+			for i, target := range []*Identifier{&link.from, &link.to} {
+				number, e := strconv.Atoi(fields[i])
+				if e != nil {
+					return nil, e
+				}
+
+				if number < 1 || number > count {
+					return nil, err.NotExistNode(fields[i])
+				}
+
+				*target = Identifier(number - 1)
+			}
+
+			if len(fields) > 2 {
+				value, e := strconv.ParseFloat(fields[2], 64)
+				if e != nil {
+					return nil, e
+				}
+
+				if value < 0 || value != math.Trunc(value) {
+					return nil, err.InvalidEdge("pajek", fmt.Sprintf("weight: %s", fields[2]))
+				}
+
+				link.distance = Distance(value)
+				weighted = true
+			}
+
+			links = append(links, link)
+		}
+	}
+
+	if e := scanner.Err(); e != nil {
+		return nil, e
+	}
+
+	graphType := UndirectedUnweighted
+	switch {
+	case directed && weighted:
+		graphType = DirectedWeighted
+	case directed:
+		graphType = DirectedUnweighted
+	case weighted:
+		graphType = UndirectedWeighted
+	}
+
+	result := NewGraph(graphType, count)
+
+	for number := 1; number <= count; number++ {
+		name, ok := names[number]
+		if !ok {
+			name = strconv.Itoa(number)
+		}
+
+		result.AddNode(name)
+	}
+
+	for _, link := range links {
+		if e := result.AddWeightEdge(link.from, link.to, link.distance); e != nil {
+			return nil, e
+		}
+
+		// Undirected edges of a directed graph are stored as a pair of arcs.
+		if directed && !link.arc {
+			if e := result.AddWeightEdge(link.to, link.from, link.distance); e != nil {
+				return nil, e
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// parsePajekVertex parses a vertex line of the form `number "name" ...` or `number name ...`.
+func parsePajekVertex(line string) (int, string, error) {
+	fields := strings.Fields(line)
+
+	number, e := strconv.Atoi(fields[0])
+	if e != nil {
+		return 0, "", e
+	}
+
+	rest := strings.TrimSpace(strings.TrimPrefix(line, fields[0]))
+
+	if strings.HasPrefix(rest, `"`) {
+		end := strings.Index(rest[1:], `"`)
+		if end < 0 {
+			return 0, "", err.InvalidFormat("pajek", line)
+		}
+
+		return number, rest[1 : end+1], nil
+	}
+
+	if len(fields) > 1 {
+		return number, fields[1], nil
+	}
+
+	return number, strconv.Itoa(number), nil
+}
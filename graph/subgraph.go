@@ -0,0 +1,91 @@
+package graph
+
+// Subgraph builds the subgraph induced by the given nodes.
+// The result contains the nodes and every edge of g between two of them; node names, node weights,
+// edge weights, and edge labels are copied. Nodes are renumbered from 0 in ascending order of their original identifiers.
+//
+// Parameters:
+//   - identifiers: The identifiers of the nodes to keep. Missing and duplicate identifiers are ignored.
+//
+// Returns:
+//   - The induced subgraph, of the same type as g and with the same self-loop and parallel edge policies,
+//     see AllowSelfLoops and AllowMultiEdges.
+//   - A map from each original identifier to its identifier in the subgraph.
+//
+// Notes:
+//   - Self-loops and parallel edges are kept, each parallel edge with its own weight and label.
+func (g *Graph) Subgraph(identifiers []Identifier) (*Graph, map[Identifier]Identifier) {
+	keep := make(map[Identifier]bool, len(identifiers))
+	for _, id := range identifiers {
+		if g.nodes.find(id) != nil {
+			keep[id] = true
+		}
+	}
+
+	result := NewGraph(g.graphType, len(keep))
+	result.selfLoops = g.selfLoops
+	result.multi = g.multi
+	mapping := make(map[Identifier]Identifier, len(keep))
+
+	for _, id := range g.sortedIDs() {
+		if keep[id] {
+			node := g.nodes.find(id)
+			mapping[id] = result.nowID
+
+			result.insertNode(result.nowID, node.Name, node.weight)
+		}
+	}
+
+	g.forEachEdge(func(from Identifier, e *Edge) {
+		if keep[from] && keep[e.to] {
+			result.copyEdge(mapping[from], mapping[e.to], e)
+		}
+	})
+
+	return result, mapping
+}
+
+// copyEdge adds a copy of the edge e, with its weight and label, between two existing nodes of the graph.
+// Unlike AddWeightEdge followed by SetEdgeLabel it never fails and labels exactly the new edge, so parallel
+// edges keep their own labels. The caller guarantees that the edge fits the type and policies of the graph.
+// As in AddWeightEdge, undirected edges are stored in both directions and a self-loop once.
+func (g *Graph) copyEdge(from, to Identifier, e *Edge) {
+	add := func(from, to Identifier) {
+		node := g.nodes.find(from)
+		node.addEdge(to, e.distance)
+		node.edges[len(node.edges)-1].label = e.label
+	}
+
+	add(from, to)
+
+	if (g.graphType == UndirectedUnweighted || g.graphType == UndirectedWeighted) && from != to {
+		add(to, from)
+	}
+
+	g.updated = false
+	g.edgeCount++
+}
+
+// EgoNetwork builds the subgraph induced by all nodes within a number of hops of a center node.
+// The nodes are found by a breadth-first search that follows outgoing edges.
+//
+// Parameters:
+//   - center: The identifier of the center node.
+//   - radius: The maximum number of hops from the center. A radius of 1 gives the center,
+//     its immediate neighbors, and the edges among them.
+//
+// Returns:
+//   - The ego network as an induced subgraph, renumbered as by Subgraph; empty if the center does not exist.
+//   - A map from each original identifier to its identifier in the ego network.
+func (g *Graph) EgoNetwork(center Identifier, radius int) (*Graph, map[Identifier]Identifier) {
+	if g.nodes.find(center) == nil {
+		return g.Subgraph(nil)
+	}
+
+	members := []Identifier{}
+	for id := range g.hopDepths(center, radius) {
+		members = append(members, id)
+	}
+
+	return g.Subgraph(members)
+}
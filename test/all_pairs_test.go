@@ -0,0 +1,182 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestJohnson(t *testing.T) {
+	cap := 25
+	g := graph.NewGraph(graph.DirectedWeighted, cap)
+	r := rand.New(rand.NewSource(3))
+
+	for i := 0; i < cap; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Weights w + p(from) - p(to) for random potentials p are often negative, but every cycle keeps
+	// its non-negative total w, so no negative cycle arises.
+	potential := make([]int64, cap)
+	for i := range potential {
+		potential[i] = int64(r.Intn(30))
+	}
+
+	negatives := 0
+	for i := 0; i < cap*3; i++ {
+		from, to := r.Intn(cap), r.Intn(cap)
+		weight := int64(r.Intn(20)) + potential[from] - potential[to]
+
+		if g.AddWeightEdge(graph.Identifier(from), graph.Identifier(to), graph.Distance(weight)) == nil && weight < 0 {
+			negatives++
+		}
+	}
+
+	if negatives == 0 || !g.HasNegativeWeights() {
+		t.Fatal("the test graph must contain negative edges")
+	}
+
+	johnson, err := algorithm.Johnson(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	floyd := algorithm.FloydWarshall(g)
+
+	for i := range floyd {
+		for j := range floyd[i] {
+			if johnson[i][j] != floyd[i][j] {
+				t.Fatalf("[%d][%d]: johnson %d, floyd-warshall %d", i, j, johnson[i][j], floyd[i][j])
+			}
+		}
+	}
+
+	dist, err := algorithm.BellmanFord(g, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for to, d := range dist {
+		if floyd[0][to] != d {
+			t.Fatalf("bellman-ford to %d: %d, floyd-warshall %d", to, d, floyd[0][to])
+		}
+	}
+}
+
+func TestNegativeCycle(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedWeighted, 4)
+
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// The cycle 0 -> 1 -> 2 -> 0 has total weight -1; node 3 only has an edge into it.
+	// A weight of -1 has the bits of graph.INF and must still count as an edge.
+	for _, e := range []struct {
+		from, to graph.Identifier
+		weight   int64
+	}{{0, 1, 2}, {1, 2, -2}, {2, 0, -1}, {3, 0, 5}} {
+		g.AddWeightEdge(e.from, e.to, graph.Distance(e.weight))
+	}
+
+	if _, err := algorithm.BellmanFord(g, 3); err == nil {
+		t.Fatal("bellman-ford must report the cycle reachable from 3")
+	}
+
+	if _, err := algorithm.Johnson(g); err == nil {
+		t.Fatal("johnson must report the negative cycle")
+	}
+
+	if floyd := algorithm.FloydWarshall(g); floyd[0][0] >= 0 || floyd[3][3] != 0 {
+		t.Fatalf("floyd-warshall diagonal: %d, %d, want a negative entry only on the cycle", floyd[0][0], floyd[3][3])
+	}
+
+	// Without the cycle, the edge of weight -1 is used by every algorithm.
+	g.RemoveEdge(0, 1)
+
+	dist, err := algorithm.BellmanFord(g, 1)
+	if err != nil || dist[2] != -2 || dist[0] != -3 {
+		t.Fatalf("bellman-ford: %v, %v", dist, err)
+	}
+
+	johnson, err := algorithm.Johnson(g)
+	if err != nil || johnson[1][0] != -3 || algorithm.FloydWarshall(g)[1][0] != -3 {
+		t.Fatalf("johnson: %v, %v", johnson, err)
+	}
+}
+
+func TestAllPairsSaturation(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedWeighted, 3)
+
+	for i := 0; i < 3; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// The path 0 -> 1 -> 2 is longer than math.MaxInt64, so its sum saturates instead of wrapping negative.
+	g.AddWeightEdge(0, 1, math.MaxInt64)
+	g.AddWeightEdge(1, 2, math.MaxInt64)
+
+	johnson, err := algorithm.Johnson(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if floyd := algorithm.FloydWarshall(g); floyd[0][2] != algorithm.INF64 || johnson[0][2] != algorithm.INF64 {
+		t.Fatalf("floyd-warshall %d, johnson %d, want INF64", floyd[0][2], johnson[0][2])
+	}
+
+	if dist, err := algorithm.BellmanFord(g, 0); err != nil || dist[2] != algorithm.INF64 {
+		t.Fatalf("bellman-ford: %v, %v", dist, err)
+	}
+}
+
+func TestNegativeWeights(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedWeighted, 3)
+
+	for i := 0; i < 3; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddWeightEdge(0, 1, 4)
+	g.AddWeightEdge(1, 2, 1)
+
+	if g.HasNegativeWeights() {
+		t.Fatal("a graph with positive weights has no negative weights")
+	}
+
+	negative := int64(-3)
+	if err := g.AddWeightEdge(0, 2, graph.Distance(negative)); err != nil {
+		t.Fatal(err)
+	}
+
+	if !g.HasNegativeWeights() {
+		t.Fatal("the negative edge 0-2 was not detected")
+	}
+
+	for _, u := range []interface {
+		ClosenessCentrality(*graph.Graph) map[graph.Identifier]float64
+	}{algorithm.NewUnit(), algorithm.NewParallelUnit(2)} {
+		func() {
+			defer func() {
+				message := fmt.Sprint(recover())
+				if !strings.Contains(message, "[0 ---> 2]") || !strings.Contains(message, "BellmanFord") {
+					t.Fatalf("unexpected panic: %s", message)
+				}
+			}()
+
+			u.ClosenessCentrality(g)
+			t.Fatal("the path computation must reject negative weights")
+		}()
+	}
+
+	// Bellman-Ford handles the negative edge.
+	dist, err := algorithm.BellmanFord(g, 0)
+	if err != nil || dist[2] != -3 {
+		t.Fatalf("bellman-ford: %v, %v", dist, err)
+	}
+}
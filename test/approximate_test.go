@@ -0,0 +1,81 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// pearson returns the Pearson correlation of two score maps over their shared keys.
+func pearson(a, b map[graph.Identifier]float64) float64 {
+	var n, sumA, sumB, sumAB, sumAA, sumBB float64
+
+	for key, x := range a {
+		y := b[key]
+		n++
+		sumA += x
+		sumB += y
+		sumAB += x * y
+		sumAA += x * x
+		sumBB += y * y
+	}
+
+	cov := sumAB/n - sumA/n*sumB/n
+	return cov / math.Sqrt((sumAA/n-sumA/n*sumA/n)*(sumBB/n-sumB/n*sumB/n))
+}
+
+func TestApproximateBetweenness(t *testing.T) {
+	g := sparseGraph(80, 3)
+	u := algorithm.NewUnit()
+
+	exact := u.BetweennessCentrality(g)
+	approx := u.ApproximateBetweenness(g, 40, 42)
+
+	r := pearson(exact, approx)
+	t.Logf("correlation with exact betweenness: %f\n", r)
+
+	if r < 0.9 {
+		t.Fatalf("approximation correlates poorly with exact betweenness: %f", r)
+	}
+
+	again := u.ApproximateBetweenness(g, 40, 42)
+	for node, score := range approx {
+		if again[node] != score {
+			t.Fatal("the same seed must reproduce the same result")
+		}
+	}
+}
+
+func TestApproximateDiameter(t *testing.T) {
+	// A path of ten nodes whose smallest identifier sits near one end: 3-1-0-2-4-5-6-7-8-9.
+	order := []graph.Identifier{3, 1, 0, 2, 4, 5, 6, 7, 8, 9}
+	g := graph.NewGraph(graph.UndirectedUnweighted, len(order))
+
+	for i := range order {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < len(order); i++ {
+		g.AddEdge(order[i-1], order[i])
+	}
+
+	exact := int64(algorithm.NewUnit().Diameter(g).Distance())
+
+	// The first sweep from node 0 only reaches eccentricity 7; the second starts at the far end 9.
+	if d := algorithm.ApproximateDiameter(g, 1); d != 7 || d > exact {
+		t.Fatalf("one sweep: %d, want the lower bound 7", d)
+	}
+
+	if d := algorithm.ApproximateDiameter(g, 2); d != exact || d != 9 {
+		t.Fatalf("two sweeps: %d, want the exact diameter %d", d, exact)
+	}
+
+	// More sweeps never exceed the true diameter.
+	random := sparseGraph(100, 3)
+	if d := algorithm.ApproximateDiameter(random, 5); d > int64(algorithm.NewUnit().Diameter(random).Distance()) {
+		t.Fatalf("estimate %d exceeds the diameter", d)
+	}
+}
@@ -0,0 +1,40 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestDegreeAssortativity(t *testing.T) {
+	cap := 6
+	star := graph.NewGraph(graph.UndirectedUnweighted, cap)
+	ring := graph.NewGraph(graph.UndirectedUnweighted, cap)
+
+	for i := 0; i < cap; i++ {
+		star.AddNode(fmt.Sprintf("%4d", i))
+		ring.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < cap; i++ {
+		star.AddEdge(0, graph.Identifier(i))
+	}
+
+	for i := 0; i < cap; i++ {
+		ring.AddEdge(graph.Identifier(i), graph.Identifier((i+1)%cap))
+	}
+
+	r := algorithm.DegreeAssortativity(star)
+	t.Logf("star: %f\n", r)
+
+	if math.Abs(r+1) > 1e-9 {
+		t.Fatalf("a star must be perfectly disassortative, got %f", r)
+	}
+
+	if r := algorithm.DegreeAssortativity(ring); r != 0 {
+		t.Fatalf("a regular graph must score 0, got %f", r)
+	}
+}
@@ -1,8 +1,11 @@
 package test
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"math/rand"
+	"sync"
 	"testing"
 	"time"
 
@@ -43,3 +46,545 @@ func TestCentrality(t *testing.T) {
 	t.Logf("degree cen: %v\n", u.DegreeCentrality(g))
 	t.Logf("eigenvector cen: %v\n", u.EigenvectorCentrality(g, 100, 1e-6))
 }
+
+func TestDirectedDegreeCentrality(t *testing.T) {
+	cap := 5
+	in := graph.NewGraph(graph.DirectedUnweighted, cap)
+	out := graph.NewGraph(graph.DirectedUnweighted, cap)
+
+	for i := 0; i < cap; i++ {
+		in.AddNode(fmt.Sprintf("%4d", i))
+		out.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Hub 0 receives every edge in `in` and emits every edge in `out`.
+	for i := 1; i < cap; i++ {
+		in.AddEdge(graph.Identifier(i), 0)
+		out.AddEdge(0, graph.Identifier(i))
+	}
+
+	u := algorithm.NewUnit()
+	pu := algorithm.NewParallelUnit(4)
+
+	if u.InDegreeCentrality(in)[0] != 1 || pu.InDegreeCentrality(in)[0] != 1 {
+		t.Fatalf("hub in-degree: %v", u.InDegreeCentrality(in))
+	}
+
+	if u.OutDegreeCentrality(in)[0] != 0 || pu.OutDegreeCentrality(in)[0] != 0 {
+		t.Fatalf("hub out-degree: %v", u.OutDegreeCentrality(in))
+	}
+
+	if u.OutDegreeCentrality(out)[0] != 1 || pu.OutDegreeCentrality(out)[0] != 1 {
+		t.Fatalf("hub out-degree: %v", u.OutDegreeCentrality(out))
+	}
+
+	if u.InDegreeCentrality(out)[0] != 0 || pu.InDegreeCentrality(out)[0] != 0 {
+		t.Fatalf("hub in-degree: %v", u.InDegreeCentrality(out))
+	}
+
+	// Total degree counts both directions for directed graphs.
+	for _, dc := range []map[graph.Identifier]float64{u.DegreeCentrality(in), pu.DegreeCentrality(out)} {
+		if dc[0] != 1 || dc[1] != 0.25 {
+			t.Fatalf("degree: %v", dc)
+		}
+	}
+
+	// RemoveNode leaves the edge 0 -> 4 behind; the removed node must not reappear as a key.
+	out.RemoveNode(4)
+
+	for _, in := range []map[graph.Identifier]float64{u.InDegreeCentrality(out), pu.InDegreeCentrality(out)} {
+		if _, exists := in[4]; exists || len(in) != cap-1 {
+			t.Fatalf("in-degree after removing node 4: %v", in)
+		}
+	}
+}
+
+// sparseGraph builds a reproducible undirected graph with roughly `degree` edges per node.
+func sparseGraph(cap, degree int) *graph.Graph {
+	g := graph.NewGraph(graph.UndirectedUnweighted, cap)
+	r := rand.New(rand.NewSource(int64(cap)))
+
+	for i := 0; i < cap; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 0; i < cap*degree/2; i++ {
+		g.AddEdge(graph.Identifier(r.Intn(cap)), graph.Identifier(r.Intn(cap)))
+	}
+
+	return g
+}
+
+func TestSparseNeighbors(t *testing.T) {
+	g := sparseGraph(100, 4)
+	matrix := g.ToMatrix()
+
+	for _, id := range g.NodeIDs() {
+		count := 0
+		for _, value := range matrix[id] {
+			if value != graph.INF {
+				count++
+			}
+		}
+
+		if count != len(g.Neighbors(id)) || count != len(g.NeighborEdges(id)) {
+			t.Fatalf("node %d: matrix degree %d, sparse degree %d", id, count, len(g.Neighbors(id)))
+		}
+	}
+
+	if g.Neighbors(graph.Identifier(1000)) != nil {
+		t.Fatal("neighbors of a missing node must be nil")
+	}
+}
+
+func BenchmarkDenseDegreeCentrality(b *testing.B) {
+	g := sparseGraph(3000, 4)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		centrality := make(map[graph.Identifier]float64)
+
+		for from, row := range g.ToMatrix() {
+			for _, value := range row {
+				if value != graph.INF {
+					centrality[graph.Identifier(from)]++
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkSparseDegreeCentrality(b *testing.B) {
+	g := sparseGraph(3000, 4)
+	u := algorithm.NewUnit()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		u.DegreeCentrality(g)
+	}
+}
+
+func TestCentralityContext(t *testing.T) {
+	g := sparseGraph(300, 4)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	s := time.Now()
+
+	if _, err := algorithm.NewUnit().BetweennessCentralityContext(ctx, g); err != context.Canceled {
+		t.Fatalf("unit betweenness: expected context.Canceled, got %v", err)
+	}
+
+	if _, err := algorithm.NewParallelUnit(8).BetweennessCentralityContext(ctx, g); err != context.Canceled {
+		t.Fatalf("parallel betweenness: expected context.Canceled, got %v", err)
+	}
+
+	if _, err := algorithm.NewUnit().EigenvectorCentralityContext(ctx, g, 100, 1e-6); err != context.Canceled {
+		t.Fatalf("unit eigenvector: expected context.Canceled, got %v", err)
+	}
+
+	if _, err := algorithm.NewParallelUnit(8).EigenvectorCentralityContext(ctx, g, 100, 1e-6); err != context.Canceled {
+		t.Fatalf("parallel eigenvector: expected context.Canceled, got %v", err)
+	}
+
+	if time.Since(s) > time.Second {
+		t.Fatalf("cancelled computations took %s", time.Since(s))
+	}
+
+	small := sparseGraph(20, 3)
+	result, err := algorithm.NewUnit().BetweennessCentralityContext(context.Background(), small)
+
+	if err != nil || len(result) != small.NodeCount() {
+		t.Fatalf("uncancelled betweenness: %v, %v", result, err)
+	}
+}
+
+func TestCentralityProgress(t *testing.T) {
+	g := sparseGraph(40, 3)
+	n := g.NodeCount()
+
+	var mu sync.Mutex
+	calls, lastDone, lastTotal := 0, 0, 0
+
+	progress := func(done, total int) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if done < lastDone {
+			t.Errorf("progress went backwards: %d after %d", done, lastDone)
+		}
+
+		calls++
+		lastDone, lastTotal = done, total
+	}
+
+	pu := algorithm.NewParallelUnit(8)
+	pu.BetweennessCentralityProgress(g, progress)
+
+	// Progress is reported once per source node.
+	if lastDone != lastTotal || lastTotal != n*(n-1) || calls != n {
+		t.Fatalf("final progress %d/%d after %d calls", lastDone, lastTotal, calls)
+	}
+
+	u := algorithm.NewUnit()
+	lastDone = 0
+	u.BetweennessCentralityProgress(g, progress)
+
+	if lastDone != lastTotal {
+		t.Fatalf("final progress %d/%d", lastDone, lastTotal)
+	}
+
+	// A nil callback disables reporting.
+	u.BetweennessCentralityProgress(g, nil)
+}
+
+func TestWeightedDegreeCentrality(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedWeighted, 8)
+
+	for i := 0; i < 8; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Node 0 has two heavy edges, node 1 has four light edges.
+	g.AddWeightEdge(0, 2, 10)
+	g.AddWeightEdge(0, 3, 10)
+	for i := 4; i < 8; i++ {
+		g.AddWeightEdge(1, graph.Identifier(i), 1)
+	}
+
+	u := algorithm.NewUnit()
+	strength := u.WeightedDegreeCentrality(g)
+
+	if strength[0] != 20 || strength[1] != 4 {
+		t.Fatalf("strength: %v", strength)
+	}
+
+	if strength[0] <= strength[1] {
+		t.Fatal("a node with few heavy edges must outrank one with many light edges")
+	}
+
+	degree := u.GeneralizedDegreeCentrality(g, 0)
+
+	if degree[0] != 2 || degree[1] != 4 {
+		t.Fatalf("alpha = 0 must reduce to the plain degree: %v", degree)
+	}
+
+	t.Logf("alpha 0.5: %v\n", u.GeneralizedDegreeCentrality(g, 0.5))
+}
+
+func TestRawCentrality(t *testing.T) {
+	g := sparseGraph(40, 4)
+	n := float64(g.NodeCount())
+
+	u := algorithm.NewUnit()
+	pu := algorithm.NewParallelUnit(4)
+
+	checks := []struct {
+		name            string
+		raw, normalized map[graph.Identifier]float64
+		factor          float64
+	}{
+		{"unit betweenness", u.BetweennessCentralityRaw(g), u.BetweennessCentrality(g), (n - 1) * (n - 2)},
+		{"parallel betweenness", pu.BetweennessCentralityRaw(g), pu.BetweennessCentrality(g), (n - 1) * (n - 2)},
+		{"unit degree", u.DegreeCentralityRaw(g), u.DegreeCentrality(g), n - 1},
+		{"parallel degree", pu.DegreeCentralityRaw(g), pu.DegreeCentrality(g), n - 1},
+	}
+
+	for _, c := range checks {
+		if len(c.raw) != len(c.normalized) {
+			t.Fatalf("%s: %d raw scores, %d normalized", c.name, len(c.raw), len(c.normalized))
+		}
+
+		for id, raw := range c.raw {
+			if math.Abs(raw/c.factor-c.normalized[id]) > 1e-12 {
+				t.Fatalf("%s of %d: raw %f / %f != %f", c.name, id, raw, c.factor, c.normalized[id])
+			}
+		}
+	}
+
+	// Degrees of a directed graph count both directions before normalization.
+	d := graph.NewGraph(graph.DirectedUnweighted, 3)
+	for i := 0; i < 3; i++ {
+		d.AddNode(fmt.Sprintf("%4d", i))
+	}
+	d.AddEdge(0, 1)
+	d.AddEdge(2, 1)
+
+	if raw := u.DegreeCentralityRaw(d); raw[0] != 1 || raw[1] != 2 || raw[2] != 1 {
+		t.Fatalf("directed raw degree: %v", raw)
+	}
+}
+
+func TestBetweennessTiedPaths(t *testing.T) {
+	// A 4-cycle 0-1-2-3-0: the pairs (0, 2) and (1, 3) each have two shortest paths.
+	g := graph.NewGraph(graph.UndirectedUnweighted, 4)
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+	for i := 0; i < 4; i++ {
+		g.AddEdge(graph.Identifier(i), graph.Identifier((i+1)%4))
+	}
+
+	// Node 1 lies on one of the two routes from 0 to 2, in both directions.
+	for _, raw := range []map[graph.Identifier]float64{
+		algorithm.NewUnit().BetweennessCentralityRaw(g),
+		algorithm.NewParallelUnit(4).BetweennessCentralityRaw(g),
+	} {
+		for id, score := range raw {
+			if score != 1 {
+				t.Fatalf("raw betweenness of %d: expected 1, got %v", id, score)
+			}
+		}
+	}
+
+	// Two weighted routes of equal length 4 between 0 and 3: 0-1-3 and 0-2-3.
+	w := graph.NewGraph(graph.UndirectedWeighted, 4)
+	for i := 0; i < 4; i++ {
+		w.AddNode(fmt.Sprintf("%4d", i))
+	}
+	w.AddWeightEdge(0, 1, 1)
+	w.AddWeightEdge(1, 3, 3)
+	w.AddWeightEdge(0, 2, 2)
+	w.AddWeightEdge(2, 3, 2)
+
+	// Nodes 1 and 2 each carry half of the 0-3 paths in both directions; 0 bridges 1 and 2.
+	raw := algorithm.NewUnit().BetweennessCentralityRaw(w)
+	if raw[1] != 1 || raw[2] != 1 || raw[0] != 2 || raw[3] != 0 {
+		t.Fatalf("weighted raw betweenness: %v", raw)
+	}
+
+	if normalized := algorithm.NewUnit().BetweennessCentrality(w); normalized[1] != 1.0/6 {
+		t.Fatalf("weighted betweenness: %v", normalized)
+	}
+}
+
+func TestBetweennessStar(t *testing.T) {
+	star := func(graphType graph.GraphType) *graph.Graph {
+		g := graph.NewGraph(graphType, 6)
+		for i := 0; i < 6; i++ {
+			g.AddNode(fmt.Sprintf("%4d", i))
+		}
+
+		for i := 1; i < 6; i++ {
+			g.AddEdge(0, graph.Identifier(i))
+			if g.Directed() {
+				g.AddEdge(graph.Identifier(i), 0)
+			}
+		}
+
+		return g
+	}
+
+	// Every pair of leaves is joined only through the center, in both directed and undirected stars.
+	for _, graphType := range []graph.GraphType{graph.UndirectedUnweighted, graph.DirectedUnweighted} {
+		g := star(graphType)
+		if g.Directed() != (graphType == graph.DirectedUnweighted) {
+			t.Fatalf("%s: Directed reports %v", graphType, g.Directed())
+		}
+
+		for _, betweenness := range []map[graph.Identifier]float64{
+			algorithm.NewUnit().BetweennessCentrality(g),
+			algorithm.NewParallelUnit(4).BetweennessCentrality(g),
+		} {
+			if betweenness[0] != 1.0 {
+				t.Fatalf("%s: center scores %v, want 1", graphType, betweenness[0])
+			}
+
+			for id := graph.Identifier(1); id < 6; id++ {
+				if betweenness[id] != 0 {
+					t.Fatalf("%s: leaf %d scores %v", graphType, id, betweenness[id])
+				}
+			}
+		}
+	}
+}
+
+func TestEigenvectorNotConverged(t *testing.T) {
+	// A star is bipartite: its eigenvalues ±√3 have equal magnitude, so power iteration oscillates.
+	star := graph.NewGraph(graph.UndirectedUnweighted, 4)
+	for i := 0; i < 4; i++ {
+		star.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < 4; i++ {
+		star.AddEdge(0, graph.Identifier(i))
+	}
+
+	if _, err := algorithm.NewUnit().EigenvectorCentralityChecked(star, 100, 1e-6); err == nil {
+		t.Fatal("expected non-convergence on a bipartite graph")
+	}
+
+	if _, err := algorithm.NewParallelUnit(4).EigenvectorCentralityChecked(star, 100, 1e-6); err == nil {
+		t.Fatal("expected non-convergence on a bipartite graph")
+	}
+
+	// Closing a triangle breaks the bipartiteness, so the iteration settles.
+	star.AddEdge(1, 2)
+
+	if _, err := algorithm.NewUnit().EigenvectorCentralityChecked(star, 1000, 1e-9); err != nil {
+		t.Fatalf("expected convergence: %v", err)
+	}
+}
+
+func TestEigenvectorZeroNorm(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedUnweighted, 5)
+	for i := 0; i < 5; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	dag := graph.NewGraph(graph.DirectedUnweighted, 3)
+	for i := 0; i < 3; i++ {
+		dag.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	dag.AddEdge(0, 1)
+	dag.AddEdge(1, 2)
+
+	check := func(name string, scores map[graph.Identifier]float64) {
+		for id, score := range scores {
+			if math.IsNaN(score) || math.IsInf(score, 0) {
+				t.Fatalf("%s: node %d has score %f", name, id, score)
+			}
+		}
+	}
+
+	// Without edges the very first product is the zero vector.
+	check("edgeless", algorithm.NewUnit().EigenvectorCentrality(g, 100, 1e-6))
+	check("dag", algorithm.NewParallelUnit(4).EigenvectorCentrality(dag, 100, 1e-6))
+
+	// A triangle next to an isolated node: the isolated node decays to 0.
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+
+	scores := algorithm.NewUnit().EigenvectorCentrality(g, 1000, 1e-9)
+	check("isolated", scores)
+
+	if scores[4] != 0 || math.Abs(scores[0]-1/math.Sqrt(3)) > 1e-6 {
+		t.Fatalf("isolated node: %v", scores)
+	}
+}
+
+func TestBetweennessWithEndpoints(t *testing.T) {
+	// A path 0-1-2-3.
+	g := graph.NewGraph(graph.UndirectedUnweighted, 4)
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < 4; i++ {
+		g.AddEdge(graph.Identifier(i-1), graph.Identifier(i))
+	}
+
+	// Exclusive raw counts over ordered pairs are {0, 4, 4, 0}; including endpoints adds 2(n-1) = 6 to each.
+	exclusive := algorithm.NewUnit().BetweennessCentrality(g)
+	want := map[graph.Identifier]float64{0: 6.0 / 12, 1: 10.0 / 12, 2: 10.0 / 12, 3: 6.0 / 12}
+
+	for _, u := range []interface {
+		BetweennessCentralityWithEndpoints(*graph.Graph) map[graph.Identifier]float64
+	}{algorithm.NewUnit(), algorithm.NewParallelUnit(4)} {
+		inclusive := u.BetweennessCentralityWithEndpoints(g)
+
+		for id, value := range want {
+			if math.Abs(inclusive[id]-value) > 1e-12 {
+				t.Fatalf("node %d: inclusive betweenness %f, want %f", id, inclusive[id], value)
+			}
+		}
+	}
+
+	if exclusive[0] != 0 || math.Abs(exclusive[1]-4.0/6) > 1e-12 {
+		t.Fatalf("exclusive betweenness: %v", exclusive)
+	}
+}
+
+func TestPercolationCentrality(t *testing.T) {
+	u := algorithm.NewUnit()
+
+	for _, g := range []*graph.Graph{sparseGraph(60, 3), randomWeightedGraph(40, 3)} {
+		equal := map[graph.Identifier]float64{}
+		for _, id := range g.NodeIDs() {
+			equal[id] = 0.5
+		}
+
+		betweenness := u.BetweennessCentrality(g)
+		percolation := u.PercolationCentrality(g, equal)
+
+		for id, value := range betweenness {
+			if math.Abs(percolation[id]-value) > 1e-12 {
+				t.Fatalf("node %d: percolation %f, betweenness %f", id, percolation[id], value)
+			}
+		}
+	}
+
+	// A path 0-1-2-3 where only node 0 is percolated: only paths from 0 count, and 3 is never between.
+	g := graph.NewGraph(graph.UndirectedUnweighted, 4)
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < 4; i++ {
+		g.AddEdge(graph.Identifier(i-1), graph.Identifier(i))
+	}
+
+	// Node 1 lies on the paths from 0 to 2 and 3, node 2 on the path from 0 to 3; each divided by n-2.
+	percolation := u.PercolationCentrality(g, map[graph.Identifier]float64{0: 1})
+	want := map[graph.Identifier]float64{0: 0, 1: 1, 2: 0.5, 3: 0}
+
+	for id, value := range want {
+		if math.Abs(percolation[id]-value) > 1e-12 {
+			t.Fatalf("node %d: percolation %f, want %f", id, percolation[id], value)
+		}
+	}
+}
+
+func TestGroupBetweenness(t *testing.T) {
+	// Two cliques {0..3} and {6..9} joined by the bridge 3-4-5-6.
+	g := graph.NewGraph(graph.UndirectedUnweighted, 10)
+	for i := 0; i < 10; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for _, offset := range []int{0, 6} {
+		for i := 0; i < 4; i++ {
+			for j := i + 1; j < 4; j++ {
+				g.AddEdge(graph.Identifier(offset+i), graph.Identifier(offset+j))
+			}
+		}
+	}
+
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 6)
+
+	u := algorithm.NewUnit()
+
+	// Node 4 separates {0..3} from {5..9}: 40 of the 72 ordered pairs of the other nodes.
+	single := u.GroupBetweenness(g, []graph.Identifier{4})
+	if math.Abs(single-40.0/72) > 1e-12 {
+		t.Fatalf("group betweenness of {4}: %f, want %f", single, 40.0/72)
+	}
+
+	// Together, the two bridge nodes lie on the 32 paths between the cliques, counted once each.
+	both := u.GroupBetweenness(g, []graph.Identifier{4, 5})
+	if math.Abs(both-32.0/56) > 1e-12 {
+		t.Fatalf("group betweenness of {4, 5}: %f, want %f", both, 32.0/56)
+	}
+
+	if both >= single+u.GroupBetweenness(g, []graph.Identifier{5}) {
+		t.Fatal("the group must not double-count paths through both members")
+	}
+
+	// Tied paths are split: in a square 0-1-2-3, half of the paths from 0 to 2 avoid 1.
+	square := graph.NewGraph(graph.UndirectedUnweighted, 4)
+	for i := 0; i < 4; i++ {
+		square.AddNode(fmt.Sprintf("%4d", i))
+	}
+	for i := 0; i < 4; i++ {
+		square.AddEdge(graph.Identifier(i), graph.Identifier((i+1)%4))
+	}
+
+	// Of the 6 ordered pairs of {0, 2, 3}, only 0-2 and 2-0 can pass through 1, each half the time.
+	if value := u.GroupBetweenness(square, []graph.Identifier{1}); math.Abs(value-1.0/6) > 1e-12 {
+		t.Fatalf("group betweenness with tied paths: %f, want %f", value, 1.0/6)
+	}
+}
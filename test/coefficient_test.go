@@ -2,6 +2,7 @@ package test
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 	"time"
@@ -44,3 +45,126 @@ func TestCoefficient(t *testing.T) {
 	t.Logf("clustering coef: %v, %f\n", glo, loc)
 	t.Logf("rich club coef: %v\n", u.RichClubCoefficient(g, 5))
 }
+
+func TestWeightedClusteringCoefficient(t *testing.T) {
+	// On an unweighted graph the weighted coefficient equals the standard one.
+	g := sparseGraph(40, 6)
+	local, _ := algorithm.NewUnit().ClusteringCoefficient(g)
+
+	for id, value := range algorithm.WeightedClusteringCoefficient(g) {
+		if math.Abs(value-local[id]) > 1e-12 {
+			t.Fatalf("node %d: weighted %f, unweighted %f", id, value, local[id])
+		}
+	}
+
+	// A triangle 0-1-2 with a pendant node 3 attached to 0.
+	w := graph.NewGraph(graph.UndirectedWeighted, 4)
+	for i := 0; i < 4; i++ {
+		w.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	w.AddWeightEdge(0, 1, 8)
+	w.AddWeightEdge(0, 2, 8)
+	w.AddWeightEdge(1, 2, 8)
+	w.AddWeightEdge(0, 3, 8)
+
+	// With equal weights node 0 closes one of its three neighbor pairs.
+	if value := algorithm.WeightedClusteringCoefficient(w)[0]; math.Abs(value-1.0/3) > 1e-12 {
+		t.Fatalf("equal weights: coefficient %f, want 1/3", value)
+	}
+
+	// Weakening the opposite edge to 1/8 of the maximum halves the triangle's contribution.
+	w.SetEdgeWeight(1, 2, 1)
+	coefficients := algorithm.WeightedClusteringCoefficient(w)
+
+	if math.Abs(coefficients[0]-1.0/6) > 1e-12 || math.Abs(coefficients[1]-0.5) > 1e-12 {
+		t.Fatalf("weakened edge: coefficients %v", coefficients)
+	}
+}
+
+func TestRichClubHubs(t *testing.T) {
+	// Four hubs form a clique and each has five leaves, so hubs have degree 8 and leaves degree 1.
+	g := graph.NewGraph(graph.UndirectedUnweighted, 24)
+	g.AllowSelfLoops(true)
+
+	for i := 0; i < 24; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 0; i < 4; i++ {
+		for j := i + 1; j < 4; j++ {
+			g.AddEdge(graph.Identifier(i), graph.Identifier(j))
+		}
+
+		for leaf := 0; leaf < 5; leaf++ {
+			g.AddEdge(graph.Identifier(i), graph.Identifier(4+5*i+leaf))
+		}
+	}
+
+	// Self-loops do not raise a leaf above the threshold.
+	g.AddEdge(4, 4)
+
+	for _, profile := range []map[int]float64{algorithm.NewUnit().RichClubProfile(g), algorithm.NewParallelUnit(4).RichClubProfile(g)} {
+		if len(profile) != 8 {
+			t.Fatalf("profile thresholds: %v", profile)
+		}
+
+		if math.Abs(profile[0]-26.0/(24*23/2)) > 1e-12 {
+			t.Fatalf("k=0: %f", profile[0])
+		}
+
+		for k := 1; k < 8; k++ {
+			if profile[k] != 1 {
+				t.Fatalf("k=%d: %f, want 1", k, profile[k])
+			}
+		}
+	}
+
+	if c := algorithm.NewUnit().RichClubCoefficient(g, 7); c != 1 {
+		t.Fatalf("RichClubCoefficient(7) = %f", c)
+	}
+
+	if c := algorithm.NewParallelUnit(4).RichClubCoefficient(g, 8); c != 0 {
+		t.Fatalf("RichClubCoefficient(8) = %f, want 0 without hubs above the threshold", c)
+	}
+}
+
+func TestTriangleParticipation(t *testing.T) {
+	// Triangles 0-1-2, 1-2-3, and 2-3-4 share edges; node 5 hangs off node 4.
+	g := graph.NewGraph(graph.UndirectedUnweighted, 6)
+	for i := 0; i < 6; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for _, edge := range [][2]graph.Identifier{{0, 1}, {0, 2}, {1, 2}, {1, 3}, {2, 3}, {2, 4}, {3, 4}, {4, 5}} {
+		g.AddEdge(edge[0], edge[1])
+	}
+
+	u := algorithm.NewUnit()
+	pu := algorithm.NewParallelUnit(4)
+
+	want := map[graph.Identifier]int{0: 1, 1: 2, 2: 3, 3: 2, 4: 1, 5: 0}
+	for _, triangles := range []map[graph.Identifier]int{u.TriangleParticipation(g), pu.TriangleParticipation(g)} {
+		if fmt.Sprint(triangles) != fmt.Sprint(want) {
+			t.Fatalf("triangles %v, want %v", triangles, want)
+		}
+	}
+
+	// The parallel count matches the sequential one, and every triangle is counted at its three nodes.
+	for _, g := range []*graph.Graph{sparseGraph(200, 6), randomWeightedGraph(60, 3)} {
+		sequential, parallel := u.TriangleParticipation(g), pu.TriangleParticipation(g)
+
+		total := 0
+		for _, id := range g.NodeIDs() {
+			if sequential[id] != parallel[id] {
+				t.Fatalf("node %d: %d triangles sequentially, %d in parallel", id, sequential[id], parallel[id])
+			}
+
+			total += sequential[id]
+		}
+
+		if total%3 != 0 {
+			t.Fatalf("participations sum to %d, not a multiple of 3", total)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestGirvanNewman(t *testing.T) {
+	size := 5
+	g := barbellGraph(size)
+
+	// A second bridge between the cliques.
+	g.AddEdge(0, graph.Identifier(size+1))
+
+	labels := algorithm.GirvanNewman(g, 2)
+	t.Logf("labels: %v\n", labels)
+
+	for i := 0; i < size; i++ {
+		if labels[graph.Identifier(i)] != 0 || labels[graph.Identifier(size+i)] != 1 {
+			t.Fatalf("cliques must separate cleanly: %v", labels)
+		}
+	}
+
+	if g.EdgeCount() != size*(size-1)+2 {
+		t.Fatal("the input graph must not be modified")
+	}
+
+	if parts := algorithm.ConnectedComponents(g); len(parts) != 1 {
+		t.Fatalf("components: %v", parts)
+	}
+}
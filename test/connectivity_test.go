@@ -0,0 +1,143 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestCondensationGraph(t *testing.T) {
+	cycle := graph.NewGraph(graph.DirectedUnweighted, 4)
+
+	for i := 0; i < 4; i++ {
+		cycle.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 0; i < 4; i++ {
+		cycle.AddEdge(graph.Identifier(i), graph.Identifier((i+1)%4))
+	}
+
+	condensed, members := algorithm.CondensationGraph(cycle)
+
+	if condensed.NodeCount() != 1 || condensed.EdgeCount() != 0 || len(members[0]) != 4 {
+		t.Fatalf("a single cycle must condense to one node without edges: %v", members)
+	}
+
+	// Two cycles {0, 1, 2} and {3, 4} joined one way, plus a sink 5.
+	g := graph.NewGraph(graph.DirectedUnweighted, 6)
+
+	for i := 0; i < 6; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 3)
+	g.AddEdge(2, 3)
+	g.AddEdge(1, 4)
+	g.AddEdge(4, 5)
+
+	condensed, members = algorithm.CondensationGraph(g)
+	t.Logf("members: %v\n", members)
+
+	if len(members) != 3 || len(members[0]) != 3 || len(members[1]) != 2 || len(members[2]) != 1 {
+		t.Fatalf("unexpected components: %v", members)
+	}
+
+	// The parallel edges 2 -> 3 and 1 -> 4 collapse into a single edge.
+	if condensed.EdgeCount() != 2 {
+		t.Fatalf("expected 2 condensed edges, got %d", condensed.EdgeCount())
+	}
+
+	for _, component := range algorithm.StronglyConnectedComponents(condensed) {
+		if len(component) != 1 {
+			t.Fatal("the condensation must be acyclic")
+		}
+	}
+}
+
+func TestWeaklyConnectedComponents(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedUnweighted, 6)
+
+	for i := 0; i < 6; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// 0 -> 1 <- 2 is weakly but not strongly connected; 3 -> 4 is a second component; 5 is isolated.
+	g.AddEdge(0, 1)
+	g.AddEdge(2, 1)
+	g.AddEdge(3, 4)
+
+	weak := algorithm.WeaklyConnectedComponents(g)
+	t.Logf("weak: %v\n", weak)
+
+	if len(weak) != 3 || len(weak[0]) != 3 || len(weak[1]) != 2 || len(weak[2]) != 1 {
+		t.Fatalf("unexpected weak components: %v", weak)
+	}
+
+	if strong := algorithm.StronglyConnectedComponents(g); len(strong) != 6 {
+		t.Fatalf("every node must be its own strong component: %v", strong)
+	}
+
+	// On a symmetric graph weak components match connected components.
+	u := sparseGraph(40, 1)
+	weak, connected := algorithm.WeaklyConnectedComponents(u), algorithm.ConnectedComponents(u)
+
+	if fmt.Sprint(weak) != fmt.Sprint(connected) {
+		t.Fatalf("weak %v differs from connected %v", weak, connected)
+	}
+}
+
+func TestNodeEdgeConnectivity(t *testing.T) {
+	build := func(graphType graph.GraphType, n int, edges [][2]int) *graph.Graph {
+		g := graph.NewGraph(graphType, n)
+
+		for i := 0; i < n; i++ {
+			g.AddNode(fmt.Sprintf("%4d", i))
+		}
+
+		for _, edge := range edges {
+			g.AddEdge(graph.Identifier(edge[0]), graph.Identifier(edge[1]))
+		}
+
+		return g
+	}
+
+	cycle := [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 5}, {5, 0}}
+	tree := [][2]int{{0, 1}, {0, 2}, {1, 3}, {1, 4}, {2, 5}}
+
+	complete := [][2]int{}
+	for i := 0; i < 5; i++ {
+		for j := i + 1; j < 5; j++ {
+			complete = append(complete, [2]int{i, j})
+		}
+	}
+
+	// Two triangles sharing node 2: one node cuts them apart, but two edges are needed.
+	bowtie := [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 2}}
+
+	for _, c := range []struct {
+		name          string
+		g             *graph.Graph
+		kappa, lambda int
+	}{
+		{"cycle", build(graph.UndirectedUnweighted, 6, cycle), 2, 2},
+		{"tree", build(graph.UndirectedUnweighted, 6, tree), 1, 1},
+		{"complete", build(graph.UndirectedUnweighted, 5, complete), 4, 4},
+		{"bowtie", build(graph.UndirectedUnweighted, 5, bowtie), 1, 2},
+		{"directed cycle", build(graph.DirectedUnweighted, 6, cycle), 1, 1},
+		{"disconnected", build(graph.UndirectedUnweighted, 7, cycle), 0, 0},
+	} {
+		if kappa := algorithm.NodeConnectivity(c.g); kappa != c.kappa {
+			t.Errorf("%s: node connectivity %d, want %d", c.name, kappa, c.kappa)
+		}
+
+		if lambda := algorithm.EdgeConnectivity(c.g); lambda != c.lambda {
+			t.Errorf("%s: edge connectivity %d, want %d", c.name, lambda, c.lambda)
+		}
+	}
+}
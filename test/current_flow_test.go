@@ -0,0 +1,51 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestCurrentFlowBetweenness(t *testing.T) {
+	build := func(n int, edges [][2]int) *graph.Graph {
+		g := graph.NewGraph(graph.UndirectedUnweighted, n)
+		for i := 0; i < n; i++ {
+			g.AddNode(fmt.Sprintf("%4d", i))
+		}
+		for _, e := range edges {
+			g.AddEdge(graph.Identifier(e[0]), graph.Identifier(e[1]))
+		}
+
+		return g
+	}
+
+	cases := []struct {
+		name     string
+		g        *graph.Graph
+		expected []float64
+	}{
+		// On a path all current follows the single route, like geodesic betweenness.
+		{"path", build(3, [][2]int{{0, 1}, {1, 2}}), []float64{0, 1, 0}},
+		// On a 4-cycle an adjacent pair sends 1/4 of its current the long way round.
+		{"cycle", build(4, [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}}), []float64{1.0 / 3, 1.0 / 3, 1.0 / 3, 1.0 / 3}},
+		// A diamond: the 1-2 bridge of the 0-3 Wheatstone network carries no current between 0 and 3.
+		{"diamond", build(4, [][2]int{{0, 1}, {0, 2}, {1, 2}, {1, 3}, {2, 3}}), []float64{1.0 / 6, 5.0 / 12, 5.0 / 12, 1.0 / 6}},
+		// Two disconnected edges and an isolated node carry no current.
+		{"disconnected", build(5, [][2]int{{0, 1}, {2, 3}}), []float64{0, 0, 0, 0, 0}},
+	}
+
+	u := algorithm.NewUnit()
+
+	for _, c := range cases {
+		actual := u.CurrentFlowBetweenness(c.g)
+
+		for i, expected := range c.expected {
+			if math.Abs(actual[graph.Identifier(i)]-expected) > 1e-9 {
+				t.Fatalf("%s: node %d expected %f, got %v", c.name, i, expected, actual)
+			}
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestLongestPath(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedWeighted, 5)
+
+	for i := 0; i < 5; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddWeightEdge(0, 4, 2)
+	g.AddWeightEdge(0, 1, 3)
+	g.AddWeightEdge(1, 2, 4)
+	g.AddWeightEdge(2, 4, 1)
+	g.AddWeightEdge(1, 3, 1)
+	g.AddWeightEdge(3, 4, 2)
+
+	path, length, e := algorithm.LongestPath(g, 0, 4)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	t.Logf("longest: %v (%d)\n", path.Nodes(), length)
+
+	if length != 8 || fmt.Sprint(path.Nodes()) != "[0 1 2 4]" || path.Distance() != 8 {
+		t.Fatalf("expected [0 1 2 4] of length 8, got %v of length %d", path.Nodes(), length)
+	}
+
+	if shortest := algorithm.ShortestPath(g, 0, 4); shortest.Distance() >= path.Distance() {
+		t.Fatalf("shortest path %d should be shorter than the longest path", shortest.Distance())
+	}
+
+	if _, length, _ := algorithm.LongestPath(g, 4, 0); length != -1 {
+		t.Fatalf("expected no path from 4 to 0, got length %d", length)
+	}
+
+	g.AddWeightEdge(4, 0, 1)
+
+	if _, _, e := algorithm.LongestPath(g, 0, 4); e == nil {
+		t.Fatal("a cyclic graph must be rejected")
+	}
+}
+
+func TestCriticalPath(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedWeighted, 6)
+
+	for i := 0; i < 6; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Tasks between project events; durations are the edge weights.
+	g.AddWeightEdge(0, 1, 3)
+	g.AddWeightEdge(0, 2, 2)
+	g.AddWeightEdge(1, 3, 4)
+	g.AddWeightEdge(2, 3, 2)
+	g.AddWeightEdge(2, 4, 6)
+	g.AddWeightEdge(3, 5, 3)
+	g.AddWeightEdge(4, 5, 1)
+
+	path, duration, e := algorithm.CriticalPath(g)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	t.Logf("critical: %v (%d)\n", path, duration)
+
+	if duration != 10 || fmt.Sprint(path) != "[0 1 3 5]" {
+		t.Fatalf("expected [0 1 3 5] of duration 10, got %v of duration %d", path, duration)
+	}
+
+	g.AddWeightEdge(5, 0, 1)
+
+	if _, _, e := algorithm.CriticalPath(g); e == nil {
+		t.Fatal("a cyclic project network must be rejected")
+	}
+}
+
+func TestPERT(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedUnweighted, 5)
+
+	for i := 0; i < 5; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	type estimate struct{ o, m, p float64 }
+
+	// Expected durations: 0-1 3, 0-2 3, 1-3 6, 2-3 1, 3-4 2.
+	tasks := map[[2]graph.Identifier]estimate{
+		{0, 1}: {2, 3, 4},
+		{0, 2}: {1, 2, 9},
+		{1, 3}: {4, 5, 12},
+		{2, 3}: {1, 1, 1},
+		{3, 4}: {1, 2, 3},
+	}
+
+	optimistic := map[[2]graph.Identifier]float64{}
+	mostLikely := map[[2]graph.Identifier]float64{}
+	pessimistic := map[[2]graph.Identifier]float64{}
+
+	for task, e := range tasks {
+		g.AddEdge(task[0], task[1])
+		optimistic[task], mostLikely[task], pessimistic[task] = e.o, e.m, e.p
+	}
+
+	duration, variance, path, e := algorithm.PERT(g, optimistic, mostLikely, pessimistic)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	// The critical path 0-1-3-4 takes 3 + 6 + 2 with variance 1/9 + 16/9 + 1/9.
+	if math.Abs(duration-11) > 1e-12 || math.Abs(variance-2) > 1e-12 || fmt.Sprint(path) != "[0 1 3 4]" {
+		t.Fatalf("expected [0 1 3 4] of duration 11 and variance 2, got %v of duration %f and variance %f",
+			path, duration, variance)
+	}
+
+	g.AddEdge(4, 0)
+
+	if _, _, _, e := algorithm.PERT(g, optimistic, mostLikely, pessimistic); e == nil {
+		t.Fatal("a cyclic project network must be rejected")
+	}
+}
+
+func TestFeedbackArcSetApprox(t *testing.T) {
+	for _, seed := range []int64{1, 2, 3} {
+		g := graph.NewGraph(graph.DirectedUnweighted, 30)
+		r := rand.New(rand.NewSource(seed))
+
+		for i := 0; i < 30; i++ {
+			g.AddNode(fmt.Sprintf("%4d", i))
+		}
+
+		for i := 0; i < 120; i++ {
+			g.AddEdge(graph.Identifier(r.Intn(30)), graph.Identifier(r.Intn(30)))
+		}
+
+		if _, e := algorithm.TopologicalSort(g); e == nil {
+			t.Fatalf("seed %d: the random graph should contain cycles", seed)
+		}
+
+		arcs := algorithm.FeedbackArcSetApprox(g)
+		if len(arcs) == 0 || len(arcs) > g.EdgeCount()/2 {
+			t.Fatalf("seed %d: %d feedback arcs out of %d edges", seed, len(arcs), g.EdgeCount())
+		}
+
+		for _, arc := range arcs {
+			if e := g.RemoveEdge(arc[0], arc[1]); e != nil {
+				t.Fatal(e)
+			}
+		}
+
+		if _, e := algorithm.TopologicalSort(g); e != nil {
+			t.Fatalf("seed %d: graph is still cyclic after removing %v: %v", seed, arcs, e)
+		}
+
+		if arcs := algorithm.FeedbackArcSetApprox(g); len(arcs) != 0 {
+			t.Fatalf("seed %d: an acyclic graph needs no feedback arcs, got %v", seed, arcs)
+		}
+	}
+
+	// A single cycle is broken by one edge.
+	cycle := graph.NewGraph(graph.DirectedUnweighted, 4)
+	for i := 0; i < 4; i++ {
+		cycle.AddNode(fmt.Sprintf("%4d", i))
+	}
+	for i := 0; i < 4; i++ {
+		cycle.AddEdge(graph.Identifier(i), graph.Identifier((i+1)%4))
+	}
+
+	if arcs := algorithm.FeedbackArcSetApprox(cycle); len(arcs) != 1 {
+		t.Fatalf("a 4-cycle needs one feedback arc, got %v", arcs)
+	}
+
+	// A self-loop is a cycle that no ordering removes, so it is always a feedback arc.
+	looped := graph.NewGraph(graph.DirectedUnweighted, 3)
+	looped.AllowSelfLoops(true)
+	for i := 0; i < 3; i++ {
+		looped.AddNode(fmt.Sprintf("%4d", i))
+	}
+	looped.AddEdge(0, 1)
+	looped.AddEdge(1, 2)
+	looped.AddEdge(1, 1)
+
+	if arcs := fmt.Sprint(algorithm.FeedbackArcSetApprox(looped)); arcs != "[[1 1]]" {
+		t.Fatalf("feedback arcs with a self-loop: %s", arcs)
+	}
+
+	looped.RemoveEdge(1, 1)
+	if _, e := algorithm.TopologicalSort(looped); e != nil {
+		t.Fatalf("graph is still cyclic after removing the loop: %v", e)
+	}
+}
@@ -0,0 +1,54 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestDegreeDistribution(t *testing.T) {
+	// A ring where every node links to its two nearest neighbors on each side is 4-regular.
+	cap := 12
+	g := graph.NewGraph(graph.UndirectedUnweighted, cap)
+
+	for i := 0; i < cap; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 0; i < cap; i++ {
+		g.AddEdge(graph.Identifier(i), graph.Identifier((i+1)%cap))
+		g.AddEdge(graph.Identifier(i), graph.Identifier((i+2)%cap))
+	}
+
+	distribution := algorithm.DegreeDistribution(g)
+	if len(distribution) != 1 || distribution[4] != cap {
+		t.Fatalf("regular graph distribution: %v", distribution)
+	}
+
+	cdf := algorithm.DegreeDistributionCDF(g)
+	if len(cdf) != 1 || cdf[4] != 1 {
+		t.Fatalf("regular graph CDF: %v", cdf)
+	}
+
+	// A star has one hub and many leaves.
+	star := graph.NewGraph(graph.DirectedUnweighted, 5)
+	for i := 0; i < 5; i++ {
+		star.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < 5; i++ {
+		star.AddEdge(graph.Identifier(i), 0)
+	}
+
+	distribution = algorithm.DegreeDistribution(star)
+	if len(distribution) != 2 || distribution[1] != 4 || distribution[4] != 1 {
+		t.Fatalf("star distribution: %v", distribution)
+	}
+
+	cdf = algorithm.DegreeDistributionCDF(star)
+	if cdf[1] != 0.8 || cdf[4] != 1 {
+		t.Fatalf("star CDF: %v", cdf)
+	}
+}
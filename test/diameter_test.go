@@ -73,3 +73,64 @@ func TestDiameter(t *testing.T) {
 	duration = time.Since(s)
 	t.Logf("Execution time: %s", duration)
 }
+
+func TestDiameterSmallGraphs(t *testing.T) {
+	empty := graph.NewGraph(graph.UndirectedUnweighted, 0)
+	single := graph.NewGraph(graph.UndirectedUnweighted, 1)
+	single.AddNode(fmt.Sprintf("%4d", 0))
+
+	for _, g := range []*graph.Graph{empty, single} {
+		compact, parallel := algorithm.NewUnit(), algorithm.NewParallelUnit(2)
+		compact.SetCompactPaths(true)
+		parallel.SetCompactPaths(true)
+
+		for _, u := range []interface {
+			Diameter(*graph.Graph) graph.Path
+		}{algorithm.NewUnit(), algorithm.NewParallelUnit(2), compact, parallel} {
+			// Without a pair of distinct nodes both modes return the same empty path.
+			if path := u.Diameter(g); path.Distance() != graph.INF || len(path.Nodes()) != 0 {
+				t.Fatalf("diameter of %d nodes: %d %v, want an empty INF path", g.NodeCount(), path.Distance(), path.Nodes())
+			}
+		}
+	}
+}
+
+func TestEffectiveDiameter(t *testing.T) {
+	// A clique of 20 nodes with one far outlier attached by a heavy edge.
+	g := graph.NewGraph(graph.UndirectedWeighted, 21)
+
+	for i := 0; i < 21; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 0; i < 20; i++ {
+		for j := i + 1; j < 20; j++ {
+			g.AddWeightEdge(graph.Identifier(i), graph.Identifier(j), 1)
+		}
+	}
+
+	g.AddWeightEdge(0, 20, 100)
+
+	for _, u := range []interface {
+		Diameter(*graph.Graph) graph.Path
+		EffectiveDiameter(*graph.Graph, float64) float64
+	}{algorithm.NewUnit(), algorithm.NewParallelUnit(4)} {
+		if d := u.Diameter(g).Distance(); d != 101 {
+			t.Fatalf("diameter %d, want 101", d)
+		}
+
+		// 380 of the 420 ordered pairs lie inside the clique, just over 90%.
+		if d := u.EffectiveDiameter(g, 0.9); d != 1 {
+			t.Fatalf("effective diameter %f, want 1", d)
+		}
+
+		// The next two pairs connect the outlier to its neighbor 0.
+		if d := u.EffectiveDiameter(g, 0.906); d != 100 {
+			t.Fatalf("90.6th percentile %f, want 100", d)
+		}
+
+		if d := u.EffectiveDiameter(g, 1); d != 101 {
+			t.Fatalf("100th percentile %f, want the diameter", d)
+		}
+	}
+}
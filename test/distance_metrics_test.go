@@ -0,0 +1,321 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestDistanceMetrics(t *testing.T) {
+	for _, g := range []*graph.Graph{sparseGraph(80, 3), randomWeightedGraph(60, 5)} {
+		pu := algorithm.NewParallelUnit(4)
+		metrics := pu.DistanceMetrics(g)
+
+		if !sameBits(metrics.Closeness, pu.ClosenessCentrality(g)) {
+			t.Fatal("bundled closeness differs from ClosenessCentrality")
+		}
+
+		if !sameBits(metrics.Harmonic, pu.HarmonicCentrality(g)) {
+			t.Fatal("bundled harmonic centrality differs from HarmonicCentrality")
+		}
+
+		eccentricity := pu.Eccentricity(g)
+		for _, id := range g.NodeIDs() {
+			if metrics.Eccentricity[id] != eccentricity[id] {
+				t.Fatalf("node %d: bundled eccentricity %d, Eccentricity %d", id, metrics.Eccentricity[id], eccentricity[id])
+			}
+		}
+
+		if metrics.AverageShortestPathLength != pu.AverageShortestPathLength(g) {
+			t.Fatalf("bundled ASPL %f, AverageShortestPathLength %f",
+				metrics.AverageShortestPathLength, pu.AverageShortestPathLength(g))
+		}
+
+		// The sequential pass must agree with the chunked one.
+		sequential := algorithm.NewUnit().DistanceMetrics(g)
+		for _, id := range g.NodeIDs() {
+			if math.Abs(sequential.Closeness[id]-metrics.Closeness[id]) > 1e-12 ||
+				math.Abs(sequential.Harmonic[id]-metrics.Harmonic[id]) > 1e-12 ||
+				sequential.Eccentricity[id] != metrics.Eccentricity[id] {
+				t.Fatalf("node %d: Unit and ParallelUnit metrics differ", id)
+			}
+		}
+	}
+}
+
+func TestDistanceMetricsPath(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedUnweighted, 4)
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+
+	metrics := algorithm.NewUnit().DistanceMetrics(g)
+
+	closeness := map[graph.Identifier]float64{0: 2.0 / 3, 1: 1, 2: 2.0 / 3, 3: 0}
+	harmonic := map[graph.Identifier]float64{0: 0.5, 1: 2.0 / 3, 2: 0.5, 3: 0}
+	eccentricity := map[graph.Identifier]graph.Distance{0: 2, 1: 1, 2: 2, 3: 0}
+
+	for id := range closeness {
+		if math.Abs(metrics.Closeness[id]-closeness[id]) > 1e-12 {
+			t.Errorf("node %d: closeness %f, want %f", id, metrics.Closeness[id], closeness[id])
+		}
+
+		if math.Abs(metrics.Harmonic[id]-harmonic[id]) > 1e-12 {
+			t.Errorf("node %d: harmonic %f, want %f", id, metrics.Harmonic[id], harmonic[id])
+		}
+
+		if metrics.Eccentricity[id] != eccentricity[id] {
+			t.Errorf("node %d: eccentricity %d, want %d", id, metrics.Eccentricity[id], eccentricity[id])
+		}
+	}
+
+	if math.Abs(metrics.AverageShortestPathLength-4.0/3) > 1e-12 {
+		t.Errorf("ASPL %f, want %f", metrics.AverageShortestPathLength, 4.0/3)
+	}
+}
+
+func TestClosenessCentralityWF(t *testing.T) {
+	// A path of five nodes 0-1-2-3-4 next to a single edge 5-6.
+	g := graph.NewGraph(graph.UndirectedUnweighted, 7)
+	for i := 0; i < 7; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < 5; i++ {
+		g.AddEdge(graph.Identifier(i-1), graph.Identifier(i))
+	}
+	g.AddEdge(5, 6)
+
+	u := algorithm.NewUnit()
+	plain := u.ClosenessCentrality(g)
+	corrected := u.ClosenessCentralityWF(g)
+
+	// Plain closeness ranks the isolated pair above the center of the path.
+	if plain[5] != 1 || plain[5] <= plain[2] {
+		t.Fatalf("plain closeness: %v", plain)
+	}
+
+	// The correction scales by the reachable fraction: 1/6 for the pair, 4/6 for the path.
+	if math.Abs(corrected[5]-1.0/6) > 1e-12 || math.Abs(corrected[2]-4.0/6*4/6) > 1e-12 {
+		t.Fatalf("corrected closeness: %v", corrected)
+	}
+
+	if corrected[5] >= corrected[2] || corrected[5] >= plain[5] {
+		t.Fatalf("the small component must be scaled down: %v", corrected)
+	}
+
+	// On a connected graph both variants agree.
+	connected := randomWeightedGraph(30, 11)
+	plain, corrected = u.ClosenessCentrality(connected), u.ClosenessCentralityWF(connected)
+
+	for id := range plain {
+		if math.Abs(plain[id]-corrected[id]) > 1e-12 {
+			t.Fatalf("node %d: closeness %f, corrected %f on a connected graph", id, plain[id], corrected[id])
+		}
+	}
+}
+
+func TestClosenessAfterRemoval(t *testing.T) {
+	for _, g := range []*graph.Graph{sparseGraph(60, 3), randomWeightedGraph(40, 7)} {
+		for _, compact := range []bool{false, true} {
+			u := algorithm.NewUnit()
+			u.SetCompactPaths(compact)
+
+			// Remove the node with the highest degree, which lies on many shortest paths.
+			removed := graph.Identifier(0)
+			for _, id := range g.NodeIDs() {
+				if len(g.Neighbors(id)) > len(g.Neighbors(removed)) {
+					removed = id
+				}
+			}
+
+			incremental := u.ClosenessAfterRemoval(g, removed)
+
+			remaining := []graph.Identifier{}
+			for _, id := range g.NodeIDs() {
+				if id != removed {
+					remaining = append(remaining, id)
+				}
+			}
+
+			subgraph, mapping := g.Subgraph(remaining)
+			fresh := algorithm.NewUnit().ClosenessCentrality(subgraph)
+
+			if len(incremental) != len(remaining) {
+				t.Fatalf("%d scores for %d remaining nodes", len(incremental), len(remaining))
+			}
+
+			for _, id := range remaining {
+				if incremental[id] != fresh[mapping[id]] {
+					t.Fatalf("node %d: incremental %f, fresh %f", id, incremental[id], fresh[mapping[id]])
+				}
+			}
+
+			// The cache still describes the unchanged graph.
+			if !sameBits(u.ClosenessCentrality(g), algorithm.NewUnit().ClosenessCentrality(g)) {
+				t.Fatal("ClosenessAfterRemoval modified the cached paths")
+			}
+		}
+	}
+}
+
+func TestGraphCenterPeriphery(t *testing.T) {
+	path := func(n int) *graph.Graph {
+		g := graph.NewGraph(graph.UndirectedUnweighted, n)
+		for i := 0; i < n; i++ {
+			g.AddNode(fmt.Sprintf("%4d", i))
+		}
+
+		for i := 1; i < n; i++ {
+			g.AddEdge(graph.Identifier(i-1), graph.Identifier(i))
+		}
+
+		return g
+	}
+
+	// An odd path has a single middle node, an even one two.
+	for _, c := range []struct {
+		n                 int
+		center, periphery string
+	}{
+		{7, "[3]", "[0 6]"},
+		{6, "[2 3]", "[0 5]"},
+		{1, "[0]", "[0]"},
+	} {
+		g := path(c.n)
+
+		if center := fmt.Sprint(algorithm.GraphCenter(g)); center != c.center {
+			t.Errorf("path of %d nodes: center %s, want %s", c.n, center, c.center)
+		}
+
+		if periphery := fmt.Sprint(algorithm.GraphPeriphery(g)); periphery != c.periphery {
+			t.Errorf("path of %d nodes: periphery %s, want %s", c.n, periphery, c.periphery)
+		}
+	}
+
+	if center := algorithm.GraphCenter(graph.NewGraph(graph.UndirectedUnweighted, 0)); len(center) != 0 {
+		t.Fatalf("empty graph: center %v", center)
+	}
+}
+
+func TestWeightedGraphCenter(t *testing.T) {
+	// A path 0-1-2-3-4-5-6 with unit weights: both centers are the middle node.
+	g := graph.NewGraph(graph.UndirectedWeighted, 7)
+	for i := 0; i < 7; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < 7; i++ {
+		g.AddWeightEdge(graph.Identifier(i-1), graph.Identifier(i), 1)
+	}
+
+	if hops, weighted := fmt.Sprint(algorithm.GraphCenterHops(g)), fmt.Sprint(algorithm.GraphCenter(g)); hops != "[3]" || weighted != "[3]" {
+		t.Fatalf("unit weights: hop center %s, weighted center %s, want [3]", hops, weighted)
+	}
+
+	// A heavy edge 1-2 pulls the weighted center toward it, while the hop center stays.
+	g.SetEdgeWeight(1, 2, 10)
+
+	u := algorithm.NewUnit()
+	hops, weighted := u.HopEccentricity(g), u.Eccentricity(g)
+
+	// Node 2 is 11 away from node 0 and 4 from node 6; node 3 is 12 away from node 0.
+	for id, want := range map[graph.Identifier][2]graph.Distance{0: {6, 15}, 2: {4, 11}, 3: {3, 12}, 6: {6, 15}} {
+		if hops[id] != want[0] || weighted[id] != want[1] {
+			t.Fatalf("node %d: hop eccentricity %d, weighted %d, want %v", id, hops[id], weighted[id], want)
+		}
+	}
+
+	if center := fmt.Sprint(algorithm.GraphCenterHops(g)); center != "[3]" {
+		t.Fatalf("hop center %s, want [3]", center)
+	}
+
+	if center := fmt.Sprint(algorithm.GraphCenter(g)); center != "[2]" {
+		t.Fatalf("weighted center %s, want [2]", center)
+	}
+
+	if periphery := fmt.Sprint(algorithm.GraphPeripheryHops(g)); periphery != "[0 6]" {
+		t.Fatalf("hop periphery %s, want [0 6]", periphery)
+	}
+}
+
+func TestComponentAwareCloseness(t *testing.T) {
+	// A path 0-1-2-3-4, a triangle 5-6-7, and the isolated node 8.
+	g := graph.NewGraph(graph.UndirectedUnweighted, 9)
+	for i := 0; i < 9; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < 5; i++ {
+		g.AddEdge(graph.Identifier(i-1), graph.Identifier(i))
+	}
+	g.AddEdge(5, 6)
+	g.AddEdge(6, 7)
+	g.AddEdge(7, 5)
+
+	closeness := algorithm.NewUnit().ComponentAwareCloseness(g)
+
+	want := map[graph.Identifier]float64{0: 0.4, 1: 4.0 / 7, 2: 4.0 / 6, 3: 4.0 / 7, 4: 0.4, 5: 1, 6: 1, 7: 1, 8: 0}
+	for id, score := range want {
+		if math.Abs(closeness[id]-score) > 1e-12 {
+			t.Errorf("node %d: closeness %f, want %f", id, closeness[id], score)
+		}
+	}
+
+	// Every component is scored as its own connected graph.
+	for _, component := range algorithm.ConnectedComponents(g) {
+		subgraph, mapping := g.Subgraph(component)
+		fresh := algorithm.NewUnit().ClosenessCentrality(subgraph)
+
+		for _, id := range component {
+			if math.IsNaN(closeness[id]) || math.Abs(closeness[id]-fresh[mapping[id]]) > 1e-12 {
+				t.Fatalf("node %d: closeness %f, within its component %f", id, closeness[id], fresh[mapping[id]])
+			}
+		}
+	}
+}
+
+func TestKCenter(t *testing.T) {
+	// A path 0-1-...-12 with unit weights.
+	g := graph.NewGraph(graph.UndirectedWeighted, 13)
+	for i := 0; i < 13; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < 13; i++ {
+		g.AddWeightEdge(graph.Identifier(i-1), graph.Identifier(i), 1)
+	}
+
+	one, radiusOne := algorithm.KCenter(g, 1)
+	two, radiusTwo := algorithm.KCenter(g, 2)
+
+	if fmt.Sprint(one) != "[0]" || radiusOne != 12 {
+		t.Fatalf("k=1: centers %v, radius %d", one, radiusOne)
+	}
+
+	// The second center goes to the far end, halving the radius.
+	if fmt.Sprint(two) != "[0 12]" || radiusTwo != 6 || radiusTwo*2 != radiusOne {
+		t.Fatalf("k=2: centers %v, radius %d", two, radiusTwo)
+	}
+
+	if centers, radius := algorithm.KCenter(g, 20); len(centers) != 13 || radius != 0 {
+		t.Fatalf("k > n: centers %v, radius %d", centers, radius)
+	}
+
+	// An isolated node is unreachable until it becomes a center itself.
+	g.AddNode(fmt.Sprintf("%4d", 13))
+
+	if centers, radius := algorithm.KCenter(g, 1); radius != algorithm.INF64 {
+		t.Fatalf("disconnected, k=1: centers %v, radius %d", centers, radius)
+	}
+
+	if centers, radius := algorithm.KCenter(g, 2); fmt.Sprint(centers) != "[0 13]" || radius != 12 {
+		t.Fatalf("disconnected, k=2: centers %v, radius %d", centers, radius)
+	}
+}
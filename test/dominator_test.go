@@ -0,0 +1,42 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestDominatorTree(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedUnweighted, 8)
+
+	for i := 0; i < 8; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// 0 enters a loop header 1, which branches to 2 and 3; both join at 4, and 5 jumps back to 1.
+	// 6 jumps into the join from outside, and 7 is reached from 2 or from the join.
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {1, 3}, {2, 4}, {3, 4}, {4, 5}, {5, 1}, {6, 4}, {2, 7}, {4, 7}} {
+		g.AddEdge(graph.Identifier(e[0]), graph.Identifier(e[1]))
+	}
+
+	idom := algorithm.DominatorTree(g, 0)
+	expected := "map[0:0 1:0 2:1 3:1 4:1 5:4 7:1]"
+
+	if fmt.Sprint(idom) != expected {
+		t.Fatalf("expected %s, got %v", expected, idom)
+	}
+
+	// From the join node, 6 and 0 are unreachable and 1 dominates the loop body.
+	idom = algorithm.DominatorTree(g, 4)
+	expected = "map[1:5 2:1 3:1 4:4 5:4 7:4]"
+
+	if fmt.Sprint(idom) != expected {
+		t.Fatalf("expected %s, got %v", expected, idom)
+	}
+
+	if len(algorithm.DominatorTree(g, 42)) != 0 {
+		t.Fatal("a missing entry must yield an empty tree")
+	}
+}
@@ -0,0 +1,89 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// barbellGraph builds two cliques of the given size joined by a single bridge edge.
+func barbellGraph(size int) *graph.Graph {
+	g := graph.NewGraph(graph.UndirectedUnweighted, size*2)
+
+	for i := 0; i < size*2; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for offset := 0; offset <= size; offset += size {
+		for i := 0; i < size; i++ {
+			for j := i + 1; j < size; j++ {
+				g.AddEdge(graph.Identifier(offset+i), graph.Identifier(offset+j))
+			}
+		}
+	}
+
+	g.AddEdge(graph.Identifier(size-1), graph.Identifier(size))
+
+	return g
+}
+
+func TestEdgeBetweenness(t *testing.T) {
+	size := 4
+	g := barbellGraph(size)
+	bridge := [2]graph.Identifier{graph.Identifier(size - 1), graph.Identifier(size)}
+
+	for _, eb := range []map[[2]graph.Identifier]float64{
+		algorithm.NewUnit().EdgeBetweenness(g),
+		algorithm.NewParallelUnit(4).EdgeBetweenness(g),
+	} {
+		t.Logf("edge betweenness: %v\n", eb)
+
+		if len(eb) != g.EdgeCount() {
+			t.Fatalf("expected %d edges, got %d", g.EdgeCount(), len(eb))
+		}
+
+		for edge, score := range eb {
+			if edge != bridge && score >= eb[bridge] {
+				t.Fatalf("edge %v (%f) must score below the bridge (%f)", edge, score, eb[bridge])
+			}
+		}
+
+		// Every ordered pair across the bridge uses it: 2 * size * size paths.
+		n := float64(g.NodeCount())
+		if eb[bridge] != float64(2*size*size)/(n*(n-1)) {
+			t.Fatalf("bridge score: %f", eb[bridge])
+		}
+	}
+}
+
+func TestEdgeBetweennessTiedPaths(t *testing.T) {
+	// A 4-cycle: opposite nodes are joined by two shortest paths, which must share the credit.
+	g := graph.NewGraph(graph.UndirectedUnweighted, 4)
+
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 0; i < 4; i++ {
+		g.AddEdge(graph.Identifier(i), graph.Identifier((i+1)%4))
+	}
+
+	for _, eb := range []map[[2]graph.Identifier]float64{
+		algorithm.NewUnit().EdgeBetweenness(g),
+		algorithm.NewParallelUnit(4).EdgeBetweenness(g),
+	} {
+		// Each edge carries its own 2 ordered pairs plus half of the 8 ordered pairs of opposite nodes.
+		for edge, score := range eb {
+			if score != 4.0/12 {
+				t.Fatalf("edge %v: %f, want every edge of the cycle to score 1/3", edge, score)
+			}
+		}
+	}
+
+	barbell := barbellGraph(5)
+	if fmt.Sprint(algorithm.NewUnit().EdgeBetweenness(barbell)) != fmt.Sprint(algorithm.NewParallelUnit(3).EdgeBetweenness(barbell)) {
+		t.Fatal("parallel edge betweenness differs from the sequential one")
+	}
+}
@@ -0,0 +1,118 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestEulerianPath(t *testing.T) {
+	build := func(graphType graph.GraphType, n int, edges [][2]int) *graph.Graph {
+		g := graph.NewGraph(graphType, n)
+		for i := 0; i < n; i++ {
+			g.AddNode(fmt.Sprintf("%4d", i))
+		}
+		for _, e := range edges {
+			g.AddEdge(graph.Identifier(e[0]), graph.Identifier(e[1]))
+		}
+
+		return g
+	}
+
+	// checkTrail verifies that the trail uses every edge of g exactly once.
+	checkTrail := func(name string, g *graph.Graph, trail []graph.Identifier) {
+		used := map[[2]graph.Identifier]bool{}
+
+		for i := 0; i+1 < len(trail); i++ {
+			key := [2]graph.Identifier{trail[i], trail[i+1]}
+			if g.Type() == graph.UndirectedUnweighted && key[0] > key[1] {
+				key[0], key[1] = key[1], key[0]
+			}
+
+			if used[key] || g.ToMatrix()[trail[i]][trail[i+1]] == graph.INF {
+				t.Fatalf("%s: invalid step %d -> %d in %v", name, trail[i], trail[i+1], trail)
+			}
+			used[key] = true
+		}
+
+		if len(used) != g.EdgeCount() {
+			t.Fatalf("%s: %d of %d edges used: %v", name, len(used), g.EdgeCount(), trail)
+		}
+	}
+
+	// A house: a square with a roof; the two roof corners 2 and 3 have odd degree.
+	house := build(graph.UndirectedUnweighted, 5, [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {2, 4}, {3, 4}})
+	trail, ok := algorithm.EulerianPath(house)
+
+	if !ok || trail[0] != 2 || trail[len(trail)-1] != 3 {
+		t.Fatalf("house: expected an open path from 2 to 3, got %v, %v", trail, ok)
+	}
+	checkTrail("house", house, trail)
+
+	// A bowtie: two triangles sharing node 2, all degrees even.
+	bowtie := build(graph.UndirectedUnweighted, 5, [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {4, 2}})
+	trail, ok = algorithm.EulerianPath(bowtie)
+
+	if !ok || trail[0] != 0 || trail[len(trail)-1] != 0 {
+		t.Fatalf("bowtie: expected a circuit from 0, got %v, %v", trail, ok)
+	}
+	checkTrail("bowtie", bowtie, trail)
+
+	// A directed path through a cycle: 3 -> 0 -> 1 -> 2 -> 0 -> 4.
+	directed := build(graph.DirectedUnweighted, 5, [][2]int{{3, 0}, {0, 1}, {1, 2}, {2, 0}, {0, 4}})
+	trail, ok = algorithm.EulerianPath(directed)
+
+	if !ok || trail[0] != 3 || trail[len(trail)-1] != 4 {
+		t.Fatalf("directed: expected an open path from 3 to 4, got %v, %v", trail, ok)
+	}
+	checkTrail("directed", directed, trail)
+
+	// A self-loop adds 2 to the degree of its node, so a triangle with a loop still has a circuit.
+	looped := graph.NewGraph(graph.UndirectedUnweighted, 3)
+	looped.AllowSelfLoops(true)
+	for i := 0; i < 3; i++ {
+		looped.AddNode(fmt.Sprintf("%4d", i))
+	}
+	for _, e := range [][2]graph.Identifier{{0, 1}, {1, 2}, {2, 0}, {1, 1}} {
+		looped.AddEdge(e[0], e[1])
+	}
+
+	trail, ok = algorithm.EulerianPath(looped)
+	if !ok || len(trail) != 5 || trail[0] != trail[4] {
+		t.Fatalf("triangle with a loop: expected a circuit, got %v, %v", trail, ok)
+	}
+	checkTrail("triangle with a loop", looped, trail)
+
+	// Parallel edges are separate edges: a doubled 0-1 edge is a circuit, and a third edge 1-2 makes 1 and 2 odd.
+	multi := graph.NewGraph(graph.UndirectedUnweighted, 3)
+	multi.AllowMultiEdges(true)
+	for i := 0; i < 3; i++ {
+		multi.AddNode(fmt.Sprintf("%4d", i))
+	}
+	multi.AddEdge(0, 1)
+	multi.AddEdge(0, 1)
+
+	if trail, ok = algorithm.EulerianPath(multi); !ok || fmt.Sprint(trail) != "[0 1 0]" {
+		t.Fatalf("doubled edge: expected the circuit [0 1 0], got %v, %v", trail, ok)
+	}
+
+	multi.AddEdge(1, 2)
+
+	if trail, ok = algorithm.EulerianPath(multi); !ok || fmt.Sprint(trail) != "[1 0 1 2]" {
+		t.Fatalf("doubled edge with a tail: expected the path [1 0 1 2], got %v, %v", trail, ok)
+	}
+
+	failures := map[string]*graph.Graph{
+		"star":         build(graph.UndirectedUnweighted, 4, [][2]int{{0, 1}, {0, 2}, {0, 3}}),
+		"disconnected": build(graph.UndirectedUnweighted, 6, [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}}),
+		"fan-out":      build(graph.DirectedUnweighted, 3, [][2]int{{0, 1}, {0, 2}}),
+	}
+
+	for name, g := range failures {
+		if trail, ok := algorithm.EulerianPath(g); ok || trail != nil {
+			t.Fatalf("%s: expected no Eulerian path, got %v", name, trail)
+		}
+	}
+}
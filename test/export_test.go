@@ -0,0 +1,311 @@
+package test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestEdgeLabelExport(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedWeighted, 4)
+
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("node %d", i))
+	}
+
+	g.AddWeightEdge(0, 1, 3)
+	g.AddWeightEdge(1, 2, 5)
+	g.AddWeightEdge(2, 3, 1)
+
+	if err := g.SetEdgeLabel(0, 1, `road "A"`); err != nil {
+		t.Fatal(err)
+	}
+
+	g.SetEdgeLabel(2, 1, "bridge")
+	g.SetNodeWeight(3, 0.5)
+
+	if g.EdgeLabel(1, 0) != `road "A"` || g.EdgeLabel(1, 2) != "bridge" {
+		t.Fatal("undirected edges must share their label in both directions")
+	}
+
+	if err := g.SetEdgeLabel(0, 3, "missing"); err == nil {
+		t.Fatal("labelling a missing edge must fail")
+	}
+
+	var dot bytes.Buffer
+	if err := graph.ExportDOT(g, &dot); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("%s\n", dot.String())
+
+	if !strings.Contains(dot.String(), `0 -- 1 [weight=3, label="road \"A\""]`) {
+		t.Fatal("DOT export must include escaped edge labels")
+	}
+
+	var graphml bytes.Buffer
+	if err := graph.ExportGraphML(g, &graphml); err != nil {
+		t.Fatal(err)
+	}
+
+	imported, err := graph.ImportGraphML(&graphml)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if imported.Type() != g.Type() || imported.ToMatrix().String() != g.ToMatrix().String() {
+		t.Fatal("GraphML round trip must preserve the type and the edges")
+	}
+
+	if imported.EdgeLabel(0, 1) != `road "A"` || imported.EdgeLabel(2, 1) != "bridge" || imported.NodeWeight(3) != 0.5 {
+		t.Fatal("GraphML round trip must preserve edge labels and node weights")
+	}
+
+	g.RemoveEdge(1, 2)
+	g.AddWeightEdge(1, 2, 5)
+
+	if g.EdgeLabel(1, 2) != "" {
+		t.Fatal("removing an edge must remove its label")
+	}
+}
+
+func TestExportGEXF(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedWeighted, 3)
+
+	g.AddNode(`<alpha & "beta">`)
+	g.AddNode("gamma")
+	g.AddNode("")
+	g.AddWeightEdge(0, 1, 4)
+	g.AddWeightEdge(1, 2, 7)
+	g.AddWeightEdge(2, 0, 1)
+	g.SetEdgeLabel(1, 2, "a<b")
+	g.SetNodeWeight(1, 2.5)
+
+	var buffer bytes.Buffer
+	if err := graph.ExportGEXF(g, &buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("%s\n", buffer.String())
+
+	// A minimal GEXF schema: the elements and attributes Gephi requires.
+	type gexfDocument struct {
+		XMLName xml.Name `xml:"http://gexf.net/1.3 gexf"`
+		Version string   `xml:"version,attr"`
+		Graph   struct {
+			DefaultEdgeType string `xml:"defaultedgetype,attr"`
+			Attributes      []struct {
+				Class string `xml:"class,attr"`
+			} `xml:"attributes"`
+			Nodes []struct {
+				ID        string `xml:"id,attr"`
+				Label     string `xml:"label,attr"`
+				AttValues []struct {
+					For   string `xml:"for,attr"`
+					Value string `xml:"value,attr"`
+				} `xml:"attvalues>attvalue"`
+			} `xml:"nodes>node"`
+			Edges []struct {
+				ID     string `xml:"id,attr"`
+				Source string `xml:"source,attr"`
+				Target string `xml:"target,attr"`
+				Weight string `xml:"weight,attr"`
+				Label  string `xml:"label,attr"`
+			} `xml:"edges>edge"`
+		} `xml:"graph"`
+	}
+
+	var doc gexfDocument
+	if err := xml.Unmarshal(buffer.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.Version != "1.3" || doc.Graph.DefaultEdgeType != "directed" || len(doc.Graph.Attributes) != 1 {
+		t.Fatalf("unexpected graph header: %+v", doc)
+	}
+
+	nodes, edges := doc.Graph.Nodes, doc.Graph.Edges
+	if len(nodes) != 3 || len(edges) != 3 {
+		t.Fatalf("expected 3 nodes and 3 edges, got %d and %d", len(nodes), len(edges))
+	}
+
+	if nodes[0].Label != `<alpha & "beta">` || nodes[2].Label != "" || nodes[1].AttValues[0].Value != "2.5" {
+		t.Fatalf("unexpected nodes: %+v", nodes)
+	}
+
+	for _, e := range edges {
+		if e.Source == "1" && (e.Target != "2" || e.Weight != "7" || e.Label != "a<b") {
+			t.Fatalf("unexpected edge: %+v", e)
+		}
+	}
+
+	// Undirected graphs write each edge once, and unweighted graphs omit weights and node attributes.
+	u := graph.NewGraph(graph.UndirectedUnweighted, 2)
+	u.AddNode("a")
+	u.AddNode("b")
+	u.AddEdge(0, 1)
+
+	buffer.Reset()
+	graph.ExportGEXF(u, &buffer)
+
+	doc = gexfDocument{}
+	if err := xml.Unmarshal(buffer.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.Graph.DefaultEdgeType != "undirected" || len(doc.Graph.Edges) != 1 || doc.Graph.Edges[0].Weight != "" ||
+		strings.Contains(buffer.String(), "attributes") {
+		t.Fatalf("unexpected undirected document: %s", buffer.String())
+	}
+}
+
+func TestPajekRoundTrip(t *testing.T) {
+	for _, graphType := range []graph.GraphType{graph.DirectedWeighted, graph.UndirectedWeighted, graph.DirectedUnweighted, graph.UndirectedUnweighted} {
+		g := graph.NewGraph(graphType, 5)
+
+		for i := 0; i < 5; i++ {
+			g.AddNode(fmt.Sprintf("node %d", i))
+		}
+
+		weight := func(w graph.Distance) graph.Distance {
+			if graphType == graph.DirectedUnweighted || graphType == graph.UndirectedUnweighted {
+				return 1
+			}
+
+			return w
+		}
+
+		g.AddWeightEdge(0, 1, weight(3))
+		g.AddWeightEdge(1, 2, weight(8))
+		g.AddWeightEdge(3, 1, weight(2))
+		g.AddWeightEdge(4, 0, weight(5))
+
+		var buffer bytes.Buffer
+		if err := graph.ExportPajek(g, &buffer); err != nil {
+			t.Fatal(err)
+		}
+
+		text := buffer.String()
+		imported, err := graph.ImportPajek(&buffer)
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", graphType, err, text)
+		}
+
+		if !graph.StrictEqual(g, imported) {
+			t.Fatalf("%s: round trip changed the graph\n%s", graphType, text)
+		}
+	}
+
+	// Hand-written documents: comments, unlisted vertices, and Edges inside a directed network.
+	document := `*Network example
+% a comment
+*Vertices 4
+1 "first vertex" 0.1 0.2
+2 second
+*Arcs
+1 2 4
+*Edges
+3 4 2.0
+`
+
+	g, err := graph.ImportPajek(strings.NewReader(document))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g.Type() != graph.DirectedWeighted || g.NodeCount() != 4 || g.EdgeCount() != 3 {
+		t.Fatalf("unexpected graph: %s, %d nodes, %d edges", g.Type(), g.NodeCount(), g.EdgeCount())
+	}
+
+	if node, _ := g.FindNode(0); node.Name != "first vertex" {
+		t.Fatalf("unexpected vertex name: %q", node.Name)
+	}
+
+	if node, _ := g.FindNode(3); node.Name != "4" {
+		t.Fatalf("unexpected default vertex name: %q", node.Name)
+	}
+
+	if matrix := g.ToMatrix(); matrix[0][1] != 4 || matrix[2][3] != 2 || matrix[3][2] != 2 {
+		t.Fatalf("unexpected edges:\n%s", matrix)
+	}
+
+	for _, invalid := range []string{"*Vertices 2\n*Edges\n1 3\n", "*Vertices 2\n*Edges\n1 2 1.5\n", "*Vertices\n"} {
+		if _, err := graph.ImportPajek(strings.NewReader(invalid)); err == nil {
+			t.Fatalf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestStreamEdgeList(t *testing.T) {
+	document := `# from,to,weight
+alice,bob,3
+bob,carol
+carol, alice, 5
+# a comment between records
+dave,alice,2
+"eve, jr.",bob,1
+`
+
+	type edge struct {
+		from, to graph.Identifier
+		weight   int64
+	}
+
+	calls := map[edge]int{}
+	count := 0
+
+	handler := func(from, to graph.Identifier, weight int64) error {
+		calls[edge{from, to, weight}]++
+		count++
+
+		return nil
+	}
+
+	if err := graph.StreamEdgeList(strings.NewReader(document), true, handler); err != nil {
+		t.Fatal(err)
+	}
+
+	// Labels are numbered by first appearance: alice 0, bob 1, carol 2, dave 3, "eve, jr." 4.
+	want := []edge{{0, 1, 3}, {1, 2, 1}, {2, 0, 5}, {3, 0, 2}, {4, 1, 1}}
+	if count != len(want) {
+		t.Fatalf("handler called %d times for %d edges", count, len(want))
+	}
+
+	for _, e := range want {
+		if calls[e] != 1 {
+			t.Fatalf("edge %v seen %d times: %v", e, calls[e], calls)
+		}
+	}
+
+	// Undirected streams order the endpoints.
+	calls, count = map[edge]int{}, 0
+	if err := graph.StreamEdgeList(strings.NewReader(document), false, handler); err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 5 || calls[edge{0, 2, 5}] != 1 || calls[edge{0, 3, 2}] != 1 || calls[edge{1, 4, 1}] != 1 {
+		t.Fatalf("undirected edges: %v", calls)
+	}
+
+	// Handler errors stop the stream.
+	stop := fmt.Errorf("stop")
+	count = 0
+	err := graph.StreamEdgeList(strings.NewReader(document), true, func(from, to graph.Identifier, weight int64) error {
+		count++
+		return stop
+	})
+
+	if err != stop || count != 1 {
+		t.Fatalf("handler error: %v after %d calls", err, count)
+	}
+
+	for _, invalid := range []string{"a\n", "a,b,c,d\n", "a,b,heavy\n"} {
+		if err := graph.StreamEdgeList(strings.NewReader(invalid), true, handler); err == nil {
+			t.Fatalf("expected an error for %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestGenerateRandomGeometric(t *testing.T) {
+	n := 100
+	previous := -1
+
+	for _, radius := range []float64{0.05, 0.1, 0.2, 0.4} {
+		g, positions := algorithm.GenerateRandomGeometric(n, radius, 42)
+
+		if g.NodeCount() != n || len(positions) != n {
+			t.Fatalf("radius %f: %d nodes, %d positions", radius, g.NodeCount(), len(positions))
+		}
+
+		if g.EdgeCount() <= previous {
+			t.Fatalf("radius %f: %d edges, not denser than %d", radius, g.EdgeCount(), previous)
+		}
+		previous = g.EdgeCount()
+
+		// Every edge joins points within the radius.
+		for _, id := range g.NodeIDs() {
+			for _, to := range g.Neighbors(id) {
+				a, b := positions[id], positions[to]
+				if math.Hypot(a[0]-b[0], a[1]-b[1]) > radius {
+					t.Fatalf("radius %f: edge %d-%d is too long", radius, id, to)
+				}
+			}
+		}
+	}
+
+	// The same seed reproduces the same graph.
+	a, _ := algorithm.GenerateRandomGeometric(n, 0.2, 7)
+	b, _ := algorithm.GenerateRandomGeometric(n, 0.2, 7)
+
+	if !graph.Equal(a, b) {
+		t.Fatal("the same seed must yield the same graph")
+	}
+}
+
+func TestGenerateSBM(t *testing.T) {
+	sizes := []int{20, 30}
+	probs := [][]float64{{0.8, 0.02}, {0.02, 0.8}}
+
+	g, blocks, err := algorithm.GenerateSBM(sizes, probs, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g.NodeCount() != 50 || len(blocks) != 50 || blocks[0] != 0 || blocks[19] != 0 || blocks[20] != 1 {
+		t.Fatalf("unexpected nodes or blocks: %d nodes, %v", g.NodeCount(), blocks)
+	}
+
+	intra, inter := 0, 0
+	for _, id := range g.NodeIDs() {
+		for _, to := range g.Neighbors(id) {
+			if blocks[id] == blocks[to] {
+				intra++
+			} else {
+				inter++
+			}
+		}
+	}
+
+	// Neighbor lists count each undirected edge twice.
+	intraDensity := float64(intra/2) / float64(20*19/2+30*29/2)
+	interDensity := float64(inter/2) / float64(20*30)
+
+	if math.Abs(intraDensity-0.8) > 0.1 || interDensity > 0.1 {
+		t.Fatalf("intra density %f, inter density %f", intraDensity, interDensity)
+	}
+
+	invalid := []struct {
+		sizes []int
+		probs [][]float64
+	}{
+		{[]int{5, 5}, [][]float64{{0.5, 0.1}}},
+		{[]int{5, 5}, [][]float64{{0.5, 0.1}, {0.1}}},
+		{[]int{5, 5}, [][]float64{{0.5, 0.1}, {0.2, 0.5}}},
+		{[]int{5, 5}, [][]float64{{1.5, 0.1}, {0.1, 0.5}}},
+		{[]int{5, -1}, [][]float64{{0.5, 0.1}, {0.1, 0.5}}},
+	}
+
+	for _, c := range invalid {
+		if _, _, err := algorithm.GenerateSBM(c.sizes, c.probs, 1); err == nil {
+			t.Fatalf("expected an error for sizes %v and probabilities %v", c.sizes, c.probs)
+		}
+	}
+}
+
+func TestGenerateConfigurationModel(t *testing.T) {
+	sequence := []int{4, 3, 3, 2, 2, 2, 1, 1, 1, 1}
+
+	for seed := int64(0); seed < 5; seed++ {
+		g, err := algorithm.GenerateConfigurationModel(sequence, seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// A sparse sequence has conflict-free matchings, so nothing is erased.
+		for i, degree := range sequence {
+			if actual := len(g.Neighbors(graph.Identifier(i))); actual != degree {
+				t.Fatalf("seed %d: node %d has degree %d, expected %d", seed, i, actual, degree)
+			}
+		}
+	}
+
+	// With erased conflicts the realized degrees never exceed the requested ones.
+	dense := []int{7, 7, 6, 6, 5, 5, 4, 4}
+	g, err := algorithm.GenerateConfigurationModel(dense, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	realized := g.DegreeSequence()
+	expected := append([]int{}, dense...)
+	sort.Sort(sort.Reverse(sort.IntSlice(expected)))
+
+	for i := range expected {
+		if realized[i] > expected[i] {
+			t.Fatalf("realized %v exceeds %v", realized, expected)
+		}
+	}
+
+	for _, invalid := range [][]int{{3, 1}, {2, 2, 1}, {1, -1}} {
+		if _, err := algorithm.GenerateConfigurationModel(invalid, 1); err == nil {
+			t.Fatalf("expected an error for %v", invalid)
+		}
+	}
+}
+
+func TestDegreePreservingShuffle(t *testing.T) {
+	for _, directed := range []bool{false, true} {
+		graphType := graph.UndirectedWeighted
+		if directed {
+			graphType = graph.DirectedWeighted
+		}
+
+		g := graph.NewGraph(graphType, 40)
+		for i := 0; i < 40; i++ {
+			g.AddNode(fmt.Sprintf("%4d", i))
+		}
+
+		// A ring with chords, heavy enough to leave room for swaps.
+		for i := 0; i < 40; i++ {
+			g.AddWeightEdge(graph.Identifier(i), graph.Identifier((i+1)%40), 1)
+			g.AddWeightEdge(graph.Identifier(i), graph.Identifier((i+7)%40), 2)
+		}
+
+		degrees := func(g *graph.Graph) map[graph.Identifier][2]int {
+			result := map[graph.Identifier][2]int{}
+			for _, id := range g.NodeIDs() {
+				for _, to := range g.Neighbors(id) {
+					if id == to {
+						t.Fatalf("self-loop at node %d", id)
+					}
+
+					out, in := result[id], result[to]
+					out[0]++
+					in[1]++
+					result[id], result[to] = out, in
+				}
+			}
+
+			return result
+		}
+
+		shuffled := algorithm.DegreePreservingShuffle(g, 400, 3)
+
+		if fmt.Sprint(shuffled.DegreeSequence()) != fmt.Sprint(g.DegreeSequence()) {
+			t.Fatalf("directed %v: degree sequence changed", directed)
+		}
+
+		before, after := degrees(g), degrees(shuffled)
+		for _, id := range g.NodeIDs() {
+			if before[id] != after[id] {
+				t.Fatalf("directed %v: node %d has degrees %v, expected %v", directed, id, after[id], before[id])
+			}
+		}
+
+		// The rewired graph differs from the ring, and the original is untouched.
+		changed := false
+		for _, id := range g.NodeIDs() {
+			if g.EdgeMultiplicity(id, (id+1)%40) == 0 {
+				t.Fatalf("directed %v: the original graph was modified", directed)
+			}
+
+			if shuffled.EdgeMultiplicity(id, (id+1)%40) == 0 {
+				changed = true
+			}
+		}
+
+		if !changed {
+			t.Fatalf("directed %v: no edge was rewired", directed)
+		}
+	}
+
+	// A complete graph cannot be rewired without multi-edges and is copied unchanged.
+	complete := graph.NewGraph(graph.UndirectedUnweighted, 5)
+	for i := 0; i < 5; i++ {
+		complete.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 0; i < 5; i++ {
+		for j := i + 1; j < 5; j++ {
+			complete.AddEdge(graph.Identifier(i), graph.Identifier(j))
+		}
+	}
+
+	if shuffled := algorithm.DegreePreservingShuffle(complete, 10, 1); shuffled.EdgeCount() != 10 {
+		t.Fatalf("complete graph: %d edges after shuffling", shuffled.EdgeCount())
+	}
+}
@@ -1,9 +1,12 @@
 package test
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/elecbug/go-graphtric/algorithm"
 	"github.com/elecbug/go-graphtric/graph"
 )
 
@@ -110,3 +113,648 @@ func TestGraph(t *testing.T) {
 
 	t.Logf("%s\n", spew.Sdump(g.ToMatrix()))
 }
+
+func TestClone(t *testing.T) {
+	g := randomWeightedGraph(20, 3)
+	u := algorithm.NewUnit()
+	before := u.AverageShortestPathLength(g)
+
+	c := g.Clone()
+
+	if c.Updated() != g.Updated() || c.NodeCount() != g.NodeCount() || c.EdgeCount() != g.EdgeCount() {
+		t.Fatal("clone must preserve the updated flag and the node and edge counts")
+	}
+
+	if c.ToMatrix().String() != g.ToMatrix().String() {
+		t.Fatal("clone must preserve edge weights")
+	}
+
+	if err := c.RemoveEdge(0, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	c.SetEdgeWeight(1, 2, 9)
+	c.AddNode("extra")
+
+	if g.EdgeCount() != c.EdgeCount()+1 || g.NodeCount() != c.NodeCount()-1 {
+		t.Fatal("editing the clone must not change the original")
+	}
+
+	if len(g.Neighbors(0)) == len(c.Neighbors(0)) {
+		t.Fatal("removing an edge from the clone must leave the original edge in place")
+	}
+
+	if !g.Updated() || u.AverageShortestPathLength(g) != before {
+		t.Fatal("editing the clone must not invalidate a Unit attached to the original")
+	}
+}
+
+func TestNodeWeight(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedWeighted, 3)
+
+	for i := 0; i < 3; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddWeightEdge(0, 1, 4)
+	g.AddWeightEdge(2, 1, 7)
+
+	if g.NodeWeight(0) != 1.0 {
+		t.Fatalf("nodes must default to weight 1.0, got %f", g.NodeWeight(0))
+	}
+
+	if err := g.SetNodeWeight(1, 2.5); err != nil {
+		t.Fatal(err)
+	}
+
+	if g.NodeWeight(1) != 2.5 {
+		t.Fatalf("expected weight 2.5, got %f", g.NodeWeight(1))
+	}
+
+	if err := g.SetNodeWeight(9, 1); err == nil {
+		t.Fatal("setting the weight of a missing node must fail")
+	}
+
+	if g.Clone().NodeWeight(1) != 2.5 {
+		t.Fatal("node weights must survive Clone")
+	}
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := &graph.Graph{}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("%s\n", data)
+
+	if decoded.NodeWeight(1) != 2.5 || decoded.NodeWeight(2) != 1.0 {
+		t.Fatal("node weights must survive JSON serialization")
+	}
+
+	if decoded.Type() != g.Type() || decoded.ToMatrix().String() != g.ToMatrix().String() {
+		t.Fatal("JSON serialization must preserve the type and the edges")
+	}
+}
+
+func TestNodeEdges(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedWeighted, 3)
+
+	for i := 0; i < 3; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddWeightEdge(0, 1, 4)
+	g.AddWeightEdge(0, 2, 7)
+
+	node, err := g.FindNode(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Edges used to be preceded by as many zero-value entries as the node has edges.
+	edges := node.Edges()
+	if len(edges) != 2 || edges[0].To() != 1 || edges[0].Distance() != 4 || edges[1].To() != 2 || edges[1].Distance() != 7 {
+		t.Fatalf("edges of node 0: %v", edges)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	a := randomWeightedGraph(15, 5)
+	b := a.Clone()
+
+	if !graph.Equal(a, b) || !graph.StrictEqual(a, b) {
+		t.Fatal("a clone must equal the original")
+	}
+
+	b.SetNodeWeight(0, 3)
+	b.SetEdgeLabel(0, 1, "label")
+
+	if !graph.Equal(a, b) {
+		t.Fatal("node weights and labels must be ignored by Equal")
+	}
+
+	if graph.StrictEqual(a, b) {
+		t.Fatal("node weights and labels must be compared by StrictEqual")
+	}
+
+	// Change a single edge weight.
+	b = a.Clone()
+	b.SetEdgeWeight(4, 5, 100)
+
+	if graph.Equal(a, b) {
+		t.Fatal("graphs differing by a single edge weight must not be equal")
+	}
+
+	directed := graph.NewGraph(graph.DirectedWeighted, 15)
+	for i := 0; i < 15; i++ {
+		directed.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	if graph.Equal(a, directed) {
+		t.Fatal("graphs of different directedness must not be equal")
+	}
+}
+
+func TestSubgraphPolicies(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedWeighted, 4)
+	g.AllowSelfLoops(true)
+	g.AllowMultiEdges(true)
+
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddWeightEdge(0, 1, 2)
+	g.AddWeightEdge(0, 1, 5)
+	g.SetEdgeLabel(0, 1, "first") // Labels the first of the parallel edges only.
+	g.AddWeightEdge(1, 1, 3)
+	g.AddWeightEdge(1, 2, 1)
+	g.AddWeightEdge(2, 3, 1)
+
+	sub, mapping := g.Subgraph([]graph.Identifier{0, 1, 2})
+
+	if !sub.SelfLoopsAllowed() || !sub.MultiEdgesAllowed() {
+		t.Fatal("the subgraph must keep the self-loop and parallel edge policies")
+	}
+
+	if sub.EdgeCount() != 4 || sub.EdgeMultiplicity(mapping[0], mapping[1]) != 2 || sub.ToMatrix()[mapping[1]][mapping[1]] != 3 {
+		t.Fatalf("the subgraph must keep the self-loop and both parallel edges: %d edges, %v", sub.EdgeCount(), sub.ToMatrix())
+	}
+
+	edges := sub.NeighborEdges(mapping[0])
+	if len(edges) != 2 || edges[0].Distance() != 2 || edges[0].Label() != "first" || edges[1].Distance() != 5 || edges[1].Label() != "" {
+		t.Fatalf("parallel edges must keep their own weights and labels: %v", edges)
+	}
+
+	// A simple graph gives a simple subgraph that still rejects loops and parallel edges.
+	simple := graph.NewGraph(graph.UndirectedUnweighted, 3)
+	for i := 0; i < 3; i++ {
+		simple.AddNode(fmt.Sprintf("%4d", i))
+	}
+	simple.AddEdge(0, 1)
+	simple.AddEdge(1, 2)
+
+	sub, _ = simple.Subgraph([]graph.Identifier{0, 1})
+
+	if sub.SelfLoopsAllowed() || sub.MultiEdgesAllowed() || sub.EdgeCount() != 1 {
+		t.Fatalf("simple subgraph: loops %v, multi %v, %d edges", sub.SelfLoopsAllowed(), sub.MultiEdgesAllowed(), sub.EdgeCount())
+	}
+
+	if sub.AddEdge(0, 0) == nil || sub.AddEdge(0, 1) == nil {
+		t.Fatal("a subgraph of a simple graph must reject self-loops and parallel edges")
+	}
+}
+
+func TestEgoNetwork(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedUnweighted, 7)
+
+	for i := 0; i < 7; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Center 0 with neighbors 1, 2; second-order neighbors 3, 4; node 5 is three hops away; node 6 is isolated.
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 4)
+	g.AddEdge(4, 5)
+
+	ego, mapping := g.EgoNetwork(0, 1)
+
+	if ego.NodeCount() != 3 || ego.EdgeCount() != 3 {
+		t.Fatalf("radius 1 must give the center, its neighbors, and the edges among them, got %d nodes and %d edges",
+			ego.NodeCount(), ego.EdgeCount())
+	}
+
+	ego, mapping = g.EgoNetwork(0, 2)
+	t.Logf("mapping: %v\n", mapping)
+
+	if ego.NodeCount() != 5 || ego.EdgeCount() != 5 {
+		t.Fatalf("radius 2 must pull in second-order neighbors, got %d nodes and %d edges", ego.NodeCount(), ego.EdgeCount())
+	}
+
+	if _, ok := mapping[5]; ok {
+		t.Fatal("node 5 is three hops away and must not be included")
+	}
+
+	node, err := ego.FindNode(mapping[4])
+	if err != nil || node.Name != "   4" {
+		t.Fatal("mapping must point at the copied node")
+	}
+}
+
+func TestNeighborsAtDistance(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedUnweighted, 6)
+
+	for i := 0; i < 6; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Path graph 0 - 1 - 2 - 3 - 4 - 5.
+	for i := 1; i < 6; i++ {
+		g.AddEdge(graph.Identifier(i-1), graph.Identifier(i))
+	}
+
+	expected := map[int]string{0: "[2]", 1: "[1 3]", 2: "[0 4]", 3: "[5]", 4: "[]"}
+
+	for k, ring := range expected {
+		if actual := fmt.Sprint(g.NeighborsAtDistance(2, k)); actual != ring {
+			t.Fatalf("ring %d: expected %s, got %s", k, ring, actual)
+		}
+	}
+
+	// Removing node 3 leaves an edge from 2 pointing at it, which the search must not follow.
+	g.RemoveNode(3)
+
+	if ring := fmt.Sprint(g.NeighborsAtDistance(2, 1)); ring != "[1]" {
+		t.Fatalf("ring 1 after removing node 3: %s", ring)
+	}
+
+	if ego, _ := g.EgoNetwork(2, 2); ego.NodeCount() != 3 {
+		t.Fatalf("ego network after removing node 3 has %d nodes", ego.NodeCount())
+	}
+}
+
+func TestReachable(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedUnweighted, 6)
+
+	for i := 0; i < 6; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// 0 -> 1 -> 2 -> 0 forms a cycle that leads to 3; 4 -> 0 and 5 are upstream or isolated.
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+	g.AddEdge(4, 0)
+
+	reachable := g.Reachable(1)
+	if len(reachable) != 4 || !reachable[0] || !reachable[1] || !reachable[2] || !reachable[3] {
+		t.Fatalf("reachable from 1: %v", reachable)
+	}
+
+	if reachable[4] || reachable[5] {
+		t.Fatalf("upstream and isolated nodes must be unreachable: %v", reachable)
+	}
+
+	if fmt.Sprint(g.Reachable(3)) != "map[3:true]" || len(g.Reachable(9)) != 0 {
+		t.Fatalf("sink: %v, missing source: %v", g.Reachable(3), g.Reachable(9))
+	}
+
+	// The edge 2 -> 3 survives the removal of node 3, but node 3 is no longer reachable.
+	g.RemoveNode(3)
+
+	if reachable := fmt.Sprint(g.Reachable(1)); reachable != "map[0:true 1:true 2:true]" {
+		t.Fatalf("reachable from 1 after removing node 3: %s", reachable)
+	}
+
+	// BetweennessCentralityWithEndpoints counts endpoints through Reachable and must not fail either.
+	if betweenness := algorithm.NewUnit().BetweennessCentralityWithEndpoints(g); betweenness[5] != 0 {
+		t.Fatalf("betweenness with endpoints after removing node 3: %v", betweenness)
+	}
+}
+
+func TestDegreeSequence(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedUnweighted, 4)
+
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(0, 3)
+	g.AddEdge(1, 2)
+
+	sequence := g.DegreeSequence()
+
+	if fmt.Sprint(sequence) != "[3 2 2 1]" {
+		t.Fatalf("expected [3 2 2 1], got %v", sequence)
+	}
+
+	if !graph.IsGraphical(sequence) {
+		t.Fatal("the degree sequence of a simple graph must be graphical")
+	}
+
+	cases := []struct {
+		sequence  []int
+		graphical bool
+	}{
+		{[]int{2, 2, 2}, true},    // triangle
+		{[]int{3, 1, 1, 1}, true}, // star
+		{[]int{2, 2}, false},      // needs a multi-edge
+		{[]int{3, 3, 1, 1}, false},
+		{[]int{1, 1, 1}, false}, // odd sum
+		{[]int{}, true},
+	}
+
+	for _, c := range cases {
+		if graph.IsGraphical(c.sequence) != c.graphical {
+			t.Fatalf("IsGraphical(%v) should be %v", c.sequence, c.graphical)
+		}
+	}
+}
+
+func TestNewGraphFromMatrix(t *testing.T) {
+	for _, g := range []*graph.Graph{sparseGraph(30, 3), randomWeightedGraph(30, 3)} {
+		rebuilt, err := graph.NewGraphFromMatrix(g.ToMatrix().Int64(), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !graph.Equal(g, rebuilt) || rebuilt.Type() != g.Type() {
+			t.Fatalf("round trip of a %s graph changed it", g.Type())
+		}
+	}
+
+	directed := graph.NewGraph(graph.DirectedWeighted, 3)
+	for i := 0; i < 3; i++ {
+		directed.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	directed.AddWeightEdge(0, 1, 4)
+	directed.AddWeightEdge(1, 2, 0)
+	directed.AddWeightEdge(2, 0, 7)
+
+	rebuilt, err := graph.NewGraphFromMatrix(directed.ToMatrix().Int64(), true)
+	if err != nil || !graph.Equal(directed, rebuilt) {
+		t.Fatalf("directed round trip failed: %v", err)
+	}
+
+	invalid := map[string][][]int64{
+		"ragged":     {{0, 1}, {1}},
+		"asymmetric": {{0, 1}, {graph.NoEdge, 0}},
+		"negative":   {{0, -2}, {-2, 0}},
+	}
+
+	for name, matrix := range invalid {
+		if _, err := graph.NewGraphFromMatrix(matrix, false); err == nil {
+			t.Errorf("%s matrix was accepted", name)
+		}
+	}
+}
+
+func TestSelfLoops(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedWeighted, 3)
+	for i := 0; i < 3; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddWeightEdge(0, 1, 2)
+	g.AddWeightEdge(1, 2, 2)
+	g.AddWeightEdge(2, 0, 2)
+
+	u := algorithm.NewUnit()
+	before, _ := u.ClusteringCoefficient(g)
+
+	// Self-loops are rejected by default, and the diagonal of ToMatrix is INF.
+	if g.AddWeightEdge(0, 0, 5) == nil {
+		t.Fatal("a self-loop was accepted without AllowSelfLoops")
+	}
+
+	if g.ToMatrix()[0][0] != graph.INF {
+		t.Fatal("the diagonal must be INF without a self-loop")
+	}
+
+	g.AllowSelfLoops(true)
+
+	if err := g.AddWeightEdge(0, 0, 5); err != nil {
+		t.Fatal(err)
+	}
+
+	matrix := g.ToMatrix()
+	if matrix[0][0] != 5 || matrix[1][1] != graph.INF {
+		t.Fatalf("diagonal: %d, %d", matrix[0][0], matrix[1][1])
+	}
+
+	// The loop is a single edge that adds 2 to the degree of its node.
+	if g.EdgeCount() != 4 || len(g.Neighbors(0)) != 3 {
+		t.Fatalf("edge count %d, neighbors %v", g.EdgeCount(), g.Neighbors(0))
+	}
+
+	if fmt.Sprint(g.DegreeSequence()) != "[4 2 2]" {
+		t.Fatalf("degree sequence: %v", g.DegreeSequence())
+	}
+
+	if degree := u.DegreeCentralityRaw(g); degree[0] != 4 || degree[1] != 2 {
+		t.Fatalf("raw degree: %v", degree)
+	}
+
+	// Clustering ignores the loop.
+	after, _ := u.ClusteringCoefficient(g)
+	for id := range before {
+		if before[id] != after[id] {
+			t.Fatalf("node %d: clustering %f with a loop, %f without", id, after[id], before[id])
+		}
+	}
+
+	// Clones and JSON keep the policy and the loop.
+	data, _ := json.Marshal(g)
+	decoded := graph.NewGraph(graph.UndirectedWeighted, 0)
+
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if !decoded.SelfLoopsAllowed() || !g.Clone().SelfLoopsAllowed() || !graph.StrictEqual(g, decoded) {
+		t.Fatal("the self-loop policy was lost")
+	}
+
+	// The weight matrix round-trips as well, the diagonal entry becoming the loop again.
+	rebuilt, err := graph.NewGraphFromMatrix(g.ToMatrix().Int64(), false)
+	if err != nil || !rebuilt.SelfLoopsAllowed() || !graph.Equal(g, rebuilt) {
+		t.Fatalf("matrix round trip with a self-loop: %v", err)
+	}
+
+	if err := g.RemoveEdge(0, 0); err != nil || g.EdgeCount() != 3 || g.ToMatrix()[0][0] != graph.INF {
+		t.Fatalf("removing the loop: %v", err)
+	}
+
+	// In a directed graph a loop is one outgoing and one incoming end.
+	d := graph.NewGraph(graph.DirectedUnweighted, 2)
+	d.AllowSelfLoops(true)
+	d.AddNode(fmt.Sprintf("%4d", 0))
+	d.AddNode(fmt.Sprintf("%4d", 1))
+	d.AddEdge(0, 0)
+	d.AddEdge(0, 1)
+
+	if degree := u.DegreeCentralityRaw(d); degree[0] != 3 || degree[1] != 1 {
+		t.Fatalf("directed raw degree: %v", degree)
+	}
+
+	if fmt.Sprint(d.DegreeSequence()) != "[3 1]" {
+		t.Fatalf("directed degree sequence: %v", d.DegreeSequence())
+	}
+}
+
+func TestMultiEdges(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedWeighted, 3)
+	for i := 0; i < 3; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddWeightEdge(0, 1, 5)
+
+	// Parallel edges are rejected outside multigraph mode.
+	if g.AddWeightEdge(1, 0, 3) == nil {
+		t.Fatal("a parallel edge was accepted in a simple graph")
+	}
+
+	g.AllowMultiEdges(true)
+	g.AddWeightEdge(1, 0, 3)
+	g.AddWeightEdge(0, 1, 4)
+	g.AddWeightEdge(1, 2, 2)
+
+	if g.EdgeMultiplicity(0, 1) != 3 || g.EdgeMultiplicity(1, 0) != 3 || g.EdgeMultiplicity(1, 2) != 1 {
+		t.Fatalf("multiplicities %d, %d, %d", g.EdgeMultiplicity(0, 1), g.EdgeMultiplicity(1, 0), g.EdgeMultiplicity(1, 2))
+	}
+
+	if g.EdgeMultiplicity(0, 2) != 0 || g.EdgeCount() != 4 {
+		t.Fatalf("edge count %d", g.EdgeCount())
+	}
+
+	// The matrix keeps the lightest parallel edge, and so does Simplify.
+	if g.ToMatrix()[0][1] != 3 {
+		t.Fatalf("matrix entry %d, want 3", g.ToMatrix()[0][1])
+	}
+
+	simple := g.Simplify()
+	if simple.EdgeCount() != 2 || simple.EdgeMultiplicity(0, 1) != 1 || simple.ToMatrix()[1][0] != 3 {
+		t.Fatalf("Simplify: %d edges, matrix entry %d", simple.EdgeCount(), simple.ToMatrix()[1][0])
+	}
+
+	if simple.MultiEdgesAllowed() || simple.AddWeightEdge(0, 1, 1) == nil {
+		t.Fatal("a simplified graph must not accept parallel edges")
+	}
+
+	summed := g.SimplifySum()
+	if summed.EdgeCount() != 2 || summed.ToMatrix()[0][1] != 12 || summed.ToMatrix()[2][1] != 2 {
+		t.Fatalf("SimplifySum: matrix entry %d, want 12", summed.ToMatrix()[0][1])
+	}
+
+	// Summing an unweighted multigraph turns multiplicities into weights.
+	d := graph.NewGraph(graph.DirectedUnweighted, 2)
+	d.AllowMultiEdges(true)
+	d.AddNode(fmt.Sprintf("%4d", 0))
+	d.AddNode(fmt.Sprintf("%4d", 1))
+	d.AddEdge(0, 1)
+	d.AddEdge(0, 1)
+	d.AddEdge(1, 0)
+
+	summed = d.SimplifySum()
+	if summed.Type() != graph.DirectedWeighted || summed.ToMatrix()[0][1] != 2 || summed.ToMatrix()[1][0] != 1 {
+		t.Fatalf("directed SimplifySum: %s, matrix\n%s", summed.Type(), summed.ToMatrix())
+	}
+
+	if c := d.Clone(); c.EdgeMultiplicity(0, 1) != 2 || !c.MultiEdgesAllowed() {
+		t.Fatal("Clone lost parallel edges")
+	}
+}
+
+func TestConcat(t *testing.T) {
+	// a is a path 0-1-2, b a triangle 0-1-2 with a labeled edge, so their identifiers overlap.
+	a := graph.NewGraph(graph.UndirectedUnweighted, 3)
+	b := graph.NewGraph(graph.UndirectedWeighted, 3)
+
+	for i := 0; i < 3; i++ {
+		a.AddNode(fmt.Sprintf("a%d", i))
+		b.AddNode(fmt.Sprintf("b%d", i))
+	}
+
+	a.AddEdge(0, 1)
+	a.AddEdge(1, 2)
+
+	b.AddWeightEdge(0, 1, 4)
+	b.AddWeightEdge(1, 2, 5)
+	b.AddWeightEdge(2, 0, 6)
+	b.SetEdgeLabel(2, 0, "back")
+
+	g, mapping := graph.Concat(a, b)
+
+	if g.NodeCount() != 6 || g.EdgeCount() != 5 || g.Type() != graph.UndirectedWeighted {
+		t.Fatalf("concat: %d nodes, %d edges, %s", g.NodeCount(), g.EdgeCount(), g.Type())
+	}
+
+	for id, shifted := range mapping {
+		if shifted != id+3 {
+			t.Fatalf("b node %d mapped to %d, want %d", id, shifted, id+3)
+		}
+
+		if node, _ := g.FindNode(shifted); node.Name != fmt.Sprintf("b%d", id) {
+			t.Fatalf("node %d is %q", shifted, node.Name)
+		}
+	}
+
+	// The edges of a stay on the original identifiers and never reach the shifted part.
+	if fmt.Sprint(g.Neighbors(1)) != "[0 2]" || g.EdgeLabel(0, 2) != "" {
+		t.Fatalf("a's edges: %v", g.Neighbors(1))
+	}
+
+	matrix := g.ToMatrix()
+	if matrix[3][4] != 4 || matrix[4][5] != 5 || matrix[5][3] != 6 || g.EdgeLabel(mapping[0], mapping[2]) != "back" {
+		t.Fatalf("b's edges were not remapped: %v", matrix)
+	}
+
+	for _, from := range []graph.Identifier{0, 1, 2} {
+		for _, to := range []graph.Identifier{3, 4, 5} {
+			if matrix[from][to] != graph.INF {
+				t.Fatalf("edge %d-%d crosses the two parts", from, to)
+			}
+		}
+	}
+}
+
+func TestEdges(t *testing.T) {
+	directed := graph.NewGraph(graph.DirectedWeighted, 30)
+	for i := 0; i < 30; i++ {
+		directed.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 0; i < 30; i++ {
+		directed.AddWeightEdge(graph.Identifier(i), graph.Identifier((i*7+3)%30), graph.Distance(i+1))
+		directed.AddWeightEdge(graph.Identifier((i*11+5)%30), graph.Identifier(i), 2)
+	}
+
+	for _, g := range []*graph.Graph{sparseGraph(100, 4), directed} {
+		isDirected := g.Directed()
+		matrix := g.ToMatrix()
+
+		entries := 0
+		for i := range matrix {
+			for j := range matrix[i] {
+				if i != j && matrix[i][j] != graph.INF {
+					entries++
+				}
+			}
+		}
+
+		if !isDirected {
+			entries /= 2
+		}
+
+		edges := g.Edges()
+		if len(edges) != entries || len(edges) != g.EdgeCount() {
+			t.Fatalf("%d edges for %d matrix entries and %d counted edges", len(edges), entries, g.EdgeCount())
+		}
+
+		for i, e := range edges {
+			if matrix[e.From][e.To] == graph.INF || int64(matrix[e.From][e.To]) != e.Weight {
+				t.Fatalf("edge %v does not match the matrix", e)
+			}
+
+			if !isDirected && e.From > e.To {
+				t.Fatalf("undirected edge %v listed from the larger identifier", e)
+			}
+
+			if i > 0 && (edges[i-1].From > e.From || edges[i-1].From == e.From && edges[i-1].To >= e.To) {
+				t.Fatalf("edges %v and %v out of order", edges[i-1], e)
+			}
+		}
+	}
+}
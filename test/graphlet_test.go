@@ -0,0 +1,67 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestCountGraphlets(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedUnweighted, 5)
+
+	for i := 0; i < 5; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Triangle 0-1-2 with a tail 2-3-4.
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+
+	counts := algorithm.NewUnit().CountGraphlets(g)
+	t.Logf("counts: %v\n", counts)
+
+	// 3 nodes: {0,1,2} is a triangle; {0,2,3}, {1,2,3}, {2,3,4} are paths.
+	// 4 nodes: {0,1,2,3} is a tailed triangle; {0,2,3,4} and {1,2,3,4} are paths.
+	expected := map[string]int{"triangle": 1, "path3": 3, "tailed-triangle": 1, "path4": 2}
+
+	if fmt.Sprint(counts) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, counts)
+	}
+
+	if parallel := algorithm.NewParallelUnit(4).CountGraphlets(g); fmt.Sprint(parallel) != fmt.Sprint(counts) {
+		t.Fatalf("parallel counts %v differ from %v", parallel, counts)
+	}
+
+	s := sparseGraph(60, 3)
+	if fmt.Sprint(algorithm.NewUnit().CountGraphlets(s)) != fmt.Sprint(algorithm.NewParallelUnit(4).CountGraphlets(s)) {
+		t.Fatal("parallel counts must match on a sparse graph")
+	}
+}
+
+func TestCountGraphletsDirected(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedUnweighted, 4)
+
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Feed-forward loop 0→1→2, 0→2 followed by 2→3.
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(0, 2)
+	g.AddEdge(2, 3)
+
+	counts := algorithm.NewUnit().CountGraphlets(g)
+	t.Logf("counts: %v\n", counts)
+
+	expected := map[string]int{"feed-forward": 1, "chain3": 2, "tailed-triangle": 1}
+
+	if fmt.Sprint(counts) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, counts)
+	}
+}
@@ -0,0 +1,100 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestHITS(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedUnweighted, 6)
+
+	for i := 0; i < 6; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Hubs 0, 1, 2 point to authorities 3, 4; only hub 0 points to authority 5.
+	for hub := 0; hub < 3; hub++ {
+		g.AddEdge(graph.Identifier(hub), 3)
+		g.AddEdge(graph.Identifier(hub), 4)
+	}
+	g.AddEdge(0, 5)
+
+	u := algorithm.NewUnit()
+	pu := algorithm.NewParallelUnit(4)
+
+	for _, run := range []func(*graph.Graph, int, float64) (map[graph.Identifier]float64, map[graph.Identifier]float64){u.HITS, pu.HITS} {
+		hubs, authorities := run(g, 100, 1e-9)
+		t.Logf("hubs: %v\nauthorities: %v\n", hubs, authorities)
+
+		for node := 3; node < 6; node++ {
+			if hubs[graph.Identifier(node)] != 0 {
+				t.Fatalf("authority %d must have a zero hub score", node)
+			}
+		}
+
+		for node := 0; node < 3; node++ {
+			if authorities[graph.Identifier(node)] != 0 {
+				t.Fatalf("hub %d must have a zero authority score", node)
+			}
+		}
+
+		if hubs[0] <= hubs[1] || authorities[3] <= authorities[5] {
+			t.Fatalf("unexpected ranking: hubs %v, authorities %v", hubs, authorities)
+		}
+	}
+}
+
+func TestSALSA(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedUnweighted, 14)
+
+	for i := 0; i < 14; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// A tightly knit community: papers 0, 1, 2 all cite 3, 4, 5.
+	for hub := 0; hub < 3; hub++ {
+		for auth := 3; auth < 6; auth++ {
+			g.AddEdge(graph.Identifier(hub), graph.Identifier(auth))
+		}
+	}
+
+	// A looser field: papers 7..11 cite the survey 6, and paper 12 cites 13.
+	for hub := 7; hub < 12; hub++ {
+		g.AddEdge(graph.Identifier(hub), 6)
+	}
+	g.AddEdge(12, 13)
+	g.AddEdge(7, 13)
+
+	u := algorithm.NewUnit()
+	hubs, authorities := u.SALSA(g, 100, 1e-12)
+	t.Logf("hubs: %v\nauthorities: %v\n", hubs, authorities)
+
+	sum := 0.0
+	for _, score := range authorities {
+		sum += score
+	}
+
+	if sum < 1-1e-9 || sum > 1+1e-9 {
+		t.Fatalf("authority scores sum to %f", sum)
+	}
+
+	// The most cited survey leads the SALSA ranking.
+	if top := algorithm.TopK(authorities, 1); top[0].Node != 6 {
+		t.Fatalf("top SALSA authority: %v", top)
+	}
+
+	for node := 0; node < 3; node++ {
+		if authorities[graph.Identifier(node)] != 0 || hubs[graph.Identifier(node)] == 0 {
+			t.Fatalf("paper %d cites but is never cited: hub %f, authority %f", node, hubs[graph.Identifier(node)], authorities[graph.Identifier(node)])
+		}
+	}
+
+	// HITS lets the dense community absorb the authority score instead.
+	_, hitsAuthorities := u.HITS(g, 100, 1e-12)
+	if hitsAuthorities[6] >= hitsAuthorities[3] {
+		t.Fatalf("HITS authorities: %v", hitsAuthorities)
+	}
+}
@@ -0,0 +1,131 @@
+package test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// randomWeightedGraph builds a reproducible undirected weighted graph with a spanning path.
+func randomWeightedGraph(cap int, seed int64) *graph.Graph {
+	g := graph.NewGraph(graph.UndirectedWeighted, cap)
+	r := rand.New(rand.NewSource(seed))
+
+	for i := 0; i < cap; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < cap; i++ {
+		g.AddWeightEdge(graph.Identifier(i-1), graph.Identifier(i), graph.Distance(r.Intn(9)+1))
+	}
+
+	for i := 0; i < cap*2; i++ {
+		from := graph.Identifier(r.Intn(cap))
+		to := graph.Identifier(r.Intn(cap))
+
+		g.AddWeightEdge(from, to, graph.Distance(r.Intn(9)+1))
+	}
+
+	return g
+}
+
+func TestUpdateEdge(t *testing.T) {
+	g := randomWeightedGraph(30, 7)
+	u := algorithm.NewUnit()
+	u.AverageShortestPathLength(g)
+
+	changes := []struct {
+		from, to graph.Identifier
+		weight   int64
+	}{
+		{3, 4, 1},  // decrease
+		{3, 4, 40}, // increase
+		{10, 11, 2},
+		{20, 21, 25},
+	}
+
+	for _, c := range changes {
+		if err := u.UpdateEdge(g, c.from, c.to, c.weight); err != nil {
+			t.Fatal(err)
+		}
+
+		if !g.Updated() {
+			t.Fatal("incremental update must keep the cache valid")
+		}
+
+		fresh := algorithm.NewUnit()
+		expected := fresh.AverageShortestPathLength(g)
+		actual := u.AverageShortestPathLength(g)
+
+		if expected != actual {
+			t.Fatalf("ASPL after %v: incremental %f, full %f", c, actual, expected)
+		}
+
+		if u.Diameter(g).Distance() != fresh.Diameter(g).Distance() {
+			t.Fatalf("diameter after %v differs", c)
+		}
+	}
+
+	if err := u.UpdateEdge(g, 0, 29, 3); err == nil {
+		t.Fatal("updating a missing edge must fail")
+	}
+}
+
+func TestUpdateEdgeMultigraph(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedWeighted, 3)
+	g.AllowMultiEdges(true)
+
+	for i := 0; i < 3; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// The first parallel edge is the lightest one, and the one UpdateEdge changes.
+	g.AddWeightEdge(0, 1, 1)
+	g.AddWeightEdge(0, 1, 5)
+	g.AddWeightEdge(1, 2, 1)
+
+	u := algorithm.NewUnit()
+	u.AverageShortestPathLength(g)
+
+	// Raising the edge from 1 to 3 makes the other parallel edge of weight 5 no shortcut, so d(0, 2) becomes 4.
+	if err := u.UpdateEdge(g, 0, 1, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected, actual := algorithm.NewUnit().Diameter(g), u.Diameter(g); actual.Distance() != 4 || expected.Distance() != 4 {
+		t.Fatalf("diameter after the update: incremental %d, full %d, want 4", actual.Distance(), expected.Distance())
+	}
+}
+
+// The edge benchmarks share a graph of 1000 nodes, large enough for the all-pairs cost to show
+// while the explicit path cache of about a million paths stays small.
+func BenchmarkUpdateEdge(b *testing.B) {
+	g := randomWeightedGraph(1000, 11)
+	u := algorithm.NewUnit()
+	u.AverageShortestPathLength(g)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if err := u.UpdateEdge(g, 500, 501, int64(i%9+1)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRecomputeAfterEdgeChange(b *testing.B) {
+	g := randomWeightedGraph(1000, 11)
+	u := algorithm.NewUnit()
+	u.AverageShortestPathLength(g)
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if err := g.SetEdgeWeight(500, 501, graph.Distance(i%9+1)); err != nil {
+			b.Fatal(err)
+		}
+
+		u.AverageShortestPathLength(g)
+	}
+}
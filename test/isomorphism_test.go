@@ -0,0 +1,100 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestIsomorphism(t *testing.T) {
+	edges := [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 4}, {4, 5}, {2, 5}}
+	perm := []int{3, 5, 0, 4, 1, 2}
+
+	a := graph.NewGraph(graph.UndirectedUnweighted, 6)
+	b := graph.NewGraph(graph.UndirectedUnweighted, 6)
+
+	for i := 0; i < 6; i++ {
+		a.AddNode(fmt.Sprintf("a%d", i))
+		b.AddNode(fmt.Sprintf("b%d", i))
+	}
+
+	for _, e := range edges {
+		a.AddEdge(graph.Identifier(e[0]), graph.Identifier(e[1]))
+		b.AddEdge(graph.Identifier(perm[e[0]]), graph.Identifier(perm[e[1]]))
+	}
+
+	if !algorithm.AreIsomorphic(a, b) {
+		t.Fatal("relabeled copies must be isomorphic")
+	}
+
+	// A 6-cycle and two disjoint triangles share the degree sequence [2,2,2,2,2,2].
+	cycle := graph.NewGraph(graph.UndirectedUnweighted, 6)
+	triangles := graph.NewGraph(graph.UndirectedUnweighted, 6)
+
+	for i := 0; i < 6; i++ {
+		cycle.AddNode(fmt.Sprintf("c%d", i))
+		triangles.AddNode(fmt.Sprintf("t%d", i))
+	}
+
+	for i := 0; i < 6; i++ {
+		cycle.AddEdge(graph.Identifier(i), graph.Identifier((i+1)%6))
+	}
+
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}} {
+		triangles.AddEdge(graph.Identifier(e[0]), graph.Identifier(e[1]))
+	}
+
+	if algorithm.AreIsomorphic(cycle, triangles) {
+		t.Fatal("a 6-cycle and two triangles must not be isomorphic")
+	}
+}
+
+func TestIsomorphismDirectedWeighted(t *testing.T) {
+	a := graph.NewGraph(graph.DirectedWeighted, 3)
+	b := graph.NewGraph(graph.DirectedWeighted, 3)
+
+	for i := 0; i < 3; i++ {
+		a.AddNode(fmt.Sprintf("a%d", i))
+		b.AddNode(fmt.Sprintf("b%d", i))
+	}
+
+	a.AddWeightEdge(0, 1, 2)
+	a.AddWeightEdge(1, 2, 5)
+	b.AddWeightEdge(2, 0, 2)
+	b.AddWeightEdge(0, 1, 5)
+
+	if !algorithm.AreIsomorphic(a, b) {
+		t.Fatal("relabeled directed weighted copies must be isomorphic")
+	}
+
+	c := graph.NewGraph(graph.DirectedWeighted, 3)
+	for i := 0; i < 3; i++ {
+		c.AddNode(fmt.Sprintf("c%d", i))
+	}
+
+	c.AddWeightEdge(0, 1, 5)
+	c.AddWeightEdge(1, 2, 2)
+
+	if algorithm.AreIsomorphic(a, c) {
+		t.Fatal("graphs with swapped edge weights must not be isomorphic")
+	}
+	// A zero-weight edge is still an edge: closing the path into a cycle differs from adding a shortcut.
+	d := a.Clone()
+	d.AddWeightEdge(2, 0, 0)
+
+	shortcut := a.Clone()
+	shortcut.AddWeightEdge(0, 2, 0)
+
+	if algorithm.AreIsomorphic(d, shortcut) {
+		t.Fatal("graphs differing by a zero-weight edge must not be isomorphic")
+	}
+
+	e := b.Clone()
+	e.AddWeightEdge(1, 2, 0)
+
+	if !algorithm.AreIsomorphic(d, e) {
+		t.Fatal("relabeled copies with a zero-weight edge must be isomorphic")
+	}
+}
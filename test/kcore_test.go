@@ -0,0 +1,90 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestKCore(t *testing.T) {
+	clique := 5
+	g := graph.NewGraph(graph.UndirectedUnweighted, clique+2)
+
+	for i := 0; i < clique+2; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 0; i < clique; i++ {
+		for j := i + 1; j < clique; j++ {
+			g.AddEdge(graph.Identifier(i), graph.Identifier(j))
+		}
+	}
+
+	// A tail hanging off the clique: 0 - 5 - 6.
+	g.AddEdge(0, graph.Identifier(clique))
+	g.AddEdge(graph.Identifier(clique), graph.Identifier(clique+1))
+
+	coreness := algorithm.KCoreDecomposition(g)
+	t.Logf("coreness: %v\n", coreness)
+
+	for i := 0; i < clique; i++ {
+		if coreness[graph.Identifier(i)] != clique-1 {
+			t.Fatalf("clique member %d: coreness %d, expected %d", i, coreness[graph.Identifier(i)], clique-1)
+		}
+	}
+
+	if coreness[graph.Identifier(clique)] != 1 || coreness[graph.Identifier(clique+1)] != 1 {
+		t.Fatalf("tail coreness: %v", coreness)
+	}
+
+	core := algorithm.KCore(g, clique-1)
+	if len(core) != clique {
+		t.Fatalf("%d-core: %v", clique-1, core)
+	}
+
+	if len(algorithm.KCore(g, clique)) != 0 {
+		t.Fatal("the k-core above the maximum coreness must be empty")
+	}
+}
+
+func TestRemovedNodeNeighbors(t *testing.T) {
+	// A triangle 0-1-2 attached through node 3 to the pair 4-5; removing node 3 leaves edges pointing at it.
+	g := graph.NewGraph(graph.UndirectedUnweighted, 6)
+	for i := 0; i < 6; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for _, edge := range [][2]graph.Identifier{{0, 1}, {1, 2}, {2, 0}, {2, 3}, {3, 4}, {3, 5}, {4, 5}} {
+		g.AddEdge(edge[0], edge[1])
+	}
+
+	g.RemoveNode(3)
+
+	core := algorithm.KCoreDecomposition(g)
+	if _, exists := core[3]; exists || core[0] != 2 || core[4] != 1 {
+		t.Fatalf("k-cores after removal: %v", core)
+	}
+
+	if components := fmt.Sprint(algorithm.WeaklyConnectedComponents(g)); components != "[[0 1 2] [4 5]]" {
+		t.Fatalf("components after removal: %s", components)
+	}
+
+	u := algorithm.NewUnit()
+	pu := algorithm.NewParallelUnit(4)
+
+	for _, triangles := range []map[graph.Identifier]int{u.TriangleParticipation(g), pu.TriangleParticipation(g)} {
+		if fmt.Sprint(triangles) != "map[0:1 1:1 2:1 4:0 5:0]" {
+			t.Fatalf("triangles after removal: %v", triangles)
+		}
+	}
+
+	// The remaining functions built on the same neighbor sets must not fail either.
+	u.CountGraphlets(g)
+	pu.CountGraphlets(g)
+	u.CurrentFlowBetweenness(g)
+	algorithm.AllPairsJaccard(g)
+	algorithm.DegreeAssortativity(g)
+	algorithm.SpectralBisection(g)
+}
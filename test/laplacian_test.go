@@ -0,0 +1,66 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestLaplacianMatrix(t *testing.T) {
+	directed := graph.NewGraph(graph.DirectedWeighted, 5)
+	for i := 0; i < 5; i++ {
+		directed.AddNode(fmt.Sprintf("%4d", i))
+	}
+	directed.AddWeightEdge(0, 1, 3)
+	directed.AddWeightEdge(1, 2, 4)
+	directed.AddWeightEdge(2, 0, 2)
+	directed.AddWeightEdge(0, 3, 5)
+
+	for _, g := range []*graph.Graph{randomWeightedGraph(20, 3), sparseGraph(30, 4), directed} {
+		laplacian := g.LaplacianMatrix()
+
+		for i, row := range laplacian {
+			sum := 0.0
+			for _, value := range row {
+				sum += value
+			}
+
+			if sum != 0 {
+				t.Fatalf("%s: row %d sums to %f", g.Type(), i, sum)
+			}
+		}
+	}
+
+	// Undirected weighted path 0 -2- 1 -3- 2.
+	path := graph.NewGraph(graph.UndirectedWeighted, 3)
+	for i := 0; i < 3; i++ {
+		path.AddNode(fmt.Sprintf("%4d", i))
+	}
+	path.AddWeightEdge(0, 1, 2)
+	path.AddWeightEdge(1, 2, 3)
+
+	expected := [][]float64{{2, -2, 0}, {-2, 5, -3}, {0, -3, 3}}
+	if fmt.Sprint(path.LaplacianMatrix()) != fmt.Sprint(expected) {
+		t.Fatalf("laplacian: %v", path.LaplacianMatrix())
+	}
+
+	normalized := path.NormalizedLaplacian()
+	for i := range normalized {
+		if normalized[i][i] != 1 {
+			t.Fatalf("normalized diagonal %d: %f", i, normalized[i][i])
+		}
+	}
+
+	if math.Abs(normalized[0][1]+2/math.Sqrt(10)) > 1e-12 || normalized[0][1] != normalized[1][0] {
+		t.Fatalf("normalized laplacian: %v", normalized)
+	}
+
+	// An isolated node has an all-zero row.
+	for _, value := range directed.NormalizedLaplacian()[4] {
+		if value != 0 {
+			t.Fatalf("isolated row: %v", directed.NormalizedLaplacian()[4])
+		}
+	}
+}
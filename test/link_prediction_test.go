@@ -0,0 +1,82 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestJaccardSimilarity(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedUnweighted, 6)
+
+	for i := 0; i < 6; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Nodes 0 and 1 share all neighbors {2, 3}; node 4 shares only 3; node 5 is isolated.
+	g.AddEdge(0, 2)
+	g.AddEdge(0, 3)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(4, 3)
+
+	if s := algorithm.JaccardSimilarity(g, 0, 1); s != 1 {
+		t.Fatalf("identical neighborhoods: %f", s)
+	}
+
+	if s := algorithm.JaccardSimilarity(g, 0, 4); s != 0.5 {
+		t.Fatalf("half-shared neighborhoods: %f", s)
+	}
+
+	if s := algorithm.JaccardSimilarity(g, 5, 5); s != 0 {
+		t.Fatalf("empty neighborhoods: %f", s)
+	}
+
+	all := algorithm.AllPairsJaccard(g)
+	t.Logf("all pairs: %v\n", all)
+
+	if all[[2]graph.Identifier{0, 1}] != 1 || all[[2]graph.Identifier{0, 4}] != 0.5 {
+		t.Fatalf("all pairs: %v", all)
+	}
+
+	if _, ok := all[[2]graph.Identifier{0, 5}]; ok {
+		t.Fatal("pairs with zero similarity must be omitted")
+	}
+}
+
+func TestLinkPredictionScores(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedUnweighted, 8)
+
+	for i := 0; i < 8; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Candidate non-edge (0, 1) shares neighbors 2 and 3.
+	// Candidate non-edge (4, 5) shares only neighbor 6, which is also a hub linked to 7, 2 and 3.
+	g.AddEdge(0, 2)
+	g.AddEdge(0, 3)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(4, 6)
+	g.AddEdge(5, 6)
+	g.AddEdge(6, 7)
+	g.AddEdge(6, 2)
+	g.AddEdge(6, 3)
+
+	if algorithm.CommonNeighbors(g, 0, 1) != 2 || algorithm.CommonNeighbors(g, 4, 5) != 1 {
+		t.Fatalf("common neighbors: %d, %d", algorithm.CommonNeighbors(g, 0, 1), algorithm.CommonNeighbors(g, 4, 5))
+	}
+
+	high, low := algorithm.AdamicAdar(g, 0, 1), algorithm.AdamicAdar(g, 4, 5)
+	t.Logf("adamic-adar: %f, %f\n", high, low)
+
+	if high <= low {
+		t.Fatal("the pair with more low-degree shared neighbors must score higher")
+	}
+
+	if algorithm.AdamicAdar(g, 0, 7) != 0 {
+		t.Fatal("nodes without shared neighbors must score 0")
+	}
+}
@@ -0,0 +1,257 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestGlobalMinCut(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedWeighted, 8)
+
+	for i := 0; i < 8; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Two dense clusters {0..3} and {4..7} with heavy internal edges.
+	for _, offset := range []int{0, 4} {
+		for i := 0; i < 4; i++ {
+			for j := i + 1; j < 4; j++ {
+				g.AddWeightEdge(graph.Identifier(offset+i), graph.Identifier(offset+j), 5)
+			}
+		}
+	}
+
+	// Two light bridges of total weight 3 separate the clusters.
+	g.AddWeightEdge(1, 5, 1)
+	g.AddWeightEdge(3, 6, 2)
+
+	weight, side := algorithm.GlobalMinCut(g)
+	t.Logf("weight: %d, side: %v\n", weight, side)
+
+	if weight != 3 {
+		t.Fatalf("expected cut weight 3, got %d", weight)
+	}
+
+	if fmt.Sprint(side) != "[0 1 2 3]" && fmt.Sprint(side) != "[4 5 6 7]" {
+		t.Fatalf("cut must separate the clusters, got %v", side)
+	}
+
+	// A disconnected graph has a zero cut.
+	g.RemoveEdge(1, 5)
+	g.RemoveEdge(3, 6)
+
+	if weight, _ := algorithm.GlobalMinCut(g); weight != 0 {
+		t.Fatalf("expected zero cut on a disconnected graph, got %d", weight)
+	}
+}
+
+func TestMaxFlow(t *testing.T) {
+	// The flow network of CLRS Figure 26.1, whose maximum flow is 23.
+	g := graph.NewGraph(graph.DirectedWeighted, 6)
+	for i := 0; i < 6; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddWeightEdge(0, 1, 16)
+	g.AddWeightEdge(0, 2, 13)
+	g.AddWeightEdge(2, 1, 4)
+	g.AddWeightEdge(1, 3, 12)
+	g.AddWeightEdge(3, 2, 9)
+	g.AddWeightEdge(2, 4, 14)
+	g.AddWeightEdge(4, 3, 7)
+	g.AddWeightEdge(3, 5, 20)
+	g.AddWeightEdge(4, 5, 4)
+
+	if flow := algorithm.MaxFlow(g, 0, 5); flow != 23 {
+		t.Fatalf("expected max flow 23, got %d", flow)
+	}
+
+	if flow := algorithm.MaxFlow(g, 5, 0); flow != 0 {
+		t.Fatalf("expected no flow against the edges, got %d", flow)
+	}
+}
+
+func TestMinCostMaxFlow(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedWeighted, 4)
+
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Source 0, sink 3. The shortest augmenting paths 0-1-3 and 0-2-3 give a maximum flow of cost 16,
+	// while detouring over the cheap edge 1-2 instead of the expensive edge 0-2 costs only 12.
+	cost := make([][]int64, 4)
+	for i := range cost {
+		cost[i] = make([]int64, 4)
+	}
+
+	for _, edge := range []struct {
+		from, to       graph.Identifier
+		capacity, cost int64
+	}{
+		{0, 1, 2, 0},
+		{0, 2, 1, 5},
+		{1, 2, 1, 1},
+		{1, 3, 1, 10},
+		{2, 3, 1, 1},
+	} {
+		g.AddWeightEdge(edge.from, edge.to, graph.Distance(edge.capacity))
+		cost[edge.from][edge.to] = edge.cost
+	}
+
+	flow, totalCost, flowMatrix, err := algorithm.MinCostMaxFlow(g, cost, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if flow != 2 || flow != algorithm.MaxFlow(g, 0, 3) {
+		t.Fatalf("flow %d, want the maximum flow 2", flow)
+	}
+
+	if totalCost != 12 {
+		t.Fatalf("cost %d, want 12", totalCost)
+	}
+
+	if flowMatrix[0][1] != 2 || flowMatrix[0][2] != 0 || flowMatrix[1][2] != 1 || flowMatrix[1][3] != 1 || flowMatrix[2][3] != 1 {
+		t.Fatalf("flow matrix: %v", flowMatrix)
+	}
+
+	if flow, totalCost, _, err := algorithm.MinCostMaxFlow(g, cost, 3, 0); err != nil || flow != 0 || totalCost != 0 {
+		t.Fatalf("reverse direction: flow %d, cost %d", flow, totalCost)
+	}
+
+	// Parallel edges add up their capacities, as in MaxFlow, although ToMatrix keeps only the lightest.
+	g.AllowMultiEdges(true)
+	g.AddWeightEdge(1, 3, 4)
+
+	flow, totalCost, flowMatrix, err = algorithm.MinCostMaxFlow(g, cost, 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if flow != 3 || flow != algorithm.MaxFlow(g, 0, 3) {
+		t.Fatalf("multigraph: flow %d, want the maximum flow 3", flow)
+	}
+
+	if totalCost != 26 || flowMatrix[1][3] != 2 {
+		t.Fatalf("multigraph: cost %d, flow matrix %v", totalCost, flowMatrix)
+	}
+}
+
+func TestMinCostMaxFlowNegativeCycle(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedWeighted, 3)
+
+	for i := 0; i < 3; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddWeightEdge(0, 1, 5)
+	g.AddWeightEdge(1, 2, 5)
+
+	// The two arcs of the undirected edge 0-1 form a cycle of cost -2, on which SPFA used to loop forever.
+	cost := [][]int64{{0, -1, 0}, {-1, 0, 0}, {0, 0, 0}}
+
+	flow, totalCost, _, err := algorithm.MinCostMaxFlow(g, cost, 0, 2)
+	if err == nil || flow != 0 || totalCost != 0 {
+		t.Fatalf("flow %d, cost %d, error %v, want a negative cycle error", flow, totalCost, err)
+	}
+
+	// A negative cost in one direction only is fine on a directed graph without cycles.
+	d := graph.NewGraph(graph.DirectedWeighted, 3)
+
+	for i := 0; i < 3; i++ {
+		d.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	d.AddWeightEdge(0, 1, 5)
+	d.AddWeightEdge(1, 2, 5)
+
+	if flow, totalCost, _, err := algorithm.MinCostMaxFlow(d, cost, 0, 2); err != nil || flow != 5 || totalCost != -5 {
+		t.Fatalf("directed: flow %d, cost %d, error %v", flow, totalCost, err)
+	}
+}
+
+func TestGomoryHuTree(t *testing.T) {
+	g := randomWeightedGraph(12, 9)
+
+	// A separate pair of nodes makes the graph disconnected.
+	a, _ := g.AddNode("a")
+	b, _ := g.AddNode("b")
+	g.AddWeightEdge(a.ID(), b.ID(), 4)
+
+	tree := algorithm.GomoryHuTree(g)
+
+	if tree.NodeCount() != g.NodeCount() || tree.EdgeCount() != g.NodeCount()-1 {
+		t.Fatalf("tree has %d nodes and %d edges", tree.NodeCount(), tree.EdgeCount())
+	}
+
+	for _, from := range g.NodeIDs() {
+		for _, to := range g.NodeIDs() {
+			if from == to {
+				continue
+			}
+
+			if cut, flow := algorithm.MinCutValue(tree, from, to), algorithm.MaxFlow(g, from, to); cut != flow {
+				t.Fatalf("%d-%d: tree cut %d, max flow %d", from, to, cut, flow)
+			}
+		}
+	}
+}
+
+func TestFlowBetweenness(t *testing.T) {
+	// Sources 0 and 1 reach sinks 3 and 4 through the wide hub 2; a narrow detour 0 -> 5 -> 3 bypasses it.
+	g := graph.NewGraph(graph.DirectedWeighted, 6)
+	for i := 0; i < 6; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for _, edge := range [][3]int{{0, 2, 10}, {1, 2, 10}, {2, 3, 10}, {2, 4, 10}, {0, 5, 1}, {5, 3, 1}} {
+		g.AddWeightEdge(graph.Identifier(edge[0]), graph.Identifier(edge[1]), graph.Distance(edge[2]))
+	}
+
+	u := algorithm.NewUnit()
+	flow := u.FlowBetweenness(g)
+
+	// The hub carries 10 for each of the pairs (0,3), (0,4), (1,3), and (1,4); the detour adds 1 to (0,3) only.
+	if flow[2] != 40 || flow[5] != 1 {
+		t.Fatalf("hub carries %f, detour %f, want 40 and 1", flow[2], flow[5])
+	}
+
+	for _, id := range []graph.Identifier{0, 1, 3, 4, 5} {
+		if flow[id] >= flow[2]/4 {
+			t.Fatalf("node %d carries %f against the hub's %f", id, flow[id], flow[2])
+		}
+	}
+
+	// Sampling every source is exact, and a smaller sample still ranks the hub first.
+	if fmt.Sprint(u.ApproximateFlowBetweenness(g, 6, 1)) != fmt.Sprint(flow) {
+		t.Fatal("a full sample differs from FlowBetweenness")
+	}
+
+	sampled := u.ApproximateFlowBetweenness(g, 3, 1)
+	for id, value := range sampled {
+		if id != 2 && value >= sampled[2] && sampled[2] > 0 {
+			t.Fatalf("sampled: node %d carries %f against the hub's %f", id, value, sampled[2])
+		}
+	}
+
+	// In a triangle the maximum flow between two corners also takes the detour over the third corner,
+	// which geodesic betweenness never credits.
+	triangle := graph.NewGraph(graph.UndirectedUnweighted, 3)
+	for i := 0; i < 3; i++ {
+		triangle.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	triangle.AddEdge(0, 1)
+	triangle.AddEdge(1, 2)
+	triangle.AddEdge(2, 0)
+
+	for id, value := range u.FlowBetweenness(triangle) {
+		if value != 2 {
+			t.Fatalf("triangle: node %d carries %f, want 2", id, value)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestWeightedPageRank(t *testing.T) {
+	build := func(heavy graph.Distance) *graph.Graph {
+		g := graph.NewGraph(graph.DirectedWeighted, 4)
+		for i := 0; i < 4; i++ {
+			g.AddNode(fmt.Sprintf("%4d", i))
+		}
+
+		// Node 0 feeds 1 and 2, both of which return to 0; node 3 is dangling and only receives.
+		g.AddWeightEdge(0, 1, heavy)
+		g.AddWeightEdge(0, 2, 1)
+		g.AddWeightEdge(1, 0, 1)
+		g.AddWeightEdge(2, 0, 1)
+		g.AddWeightEdge(2, 3, 1)
+
+		return g
+	}
+
+	u := algorithm.NewUnit()
+
+	for _, heavy := range []graph.Distance{1, 9} {
+		ranks := u.WeightedPageRank(build(heavy), 0.85, 200, 1e-12)
+
+		sum := 0.0
+		for _, rank := range ranks {
+			sum += rank
+		}
+
+		if len(ranks) != 4 || math.Abs(sum-1) > 1e-9 {
+			t.Fatalf("heavy %d: ranks %v sum to %f", heavy, ranks, sum)
+		}
+
+		// The dangling node still teleports, so nothing is lost and every node keeps some rank.
+		if ranks[3] <= 0 {
+			t.Fatalf("heavy %d: dangling node has rank %f", heavy, ranks[3])
+		}
+
+		// With equal weights nodes 1 and 2 receive the same share of node 0; the heavy edge funnels most of it to node 1.
+		switch heavy {
+		case 1:
+			if math.Abs(ranks[1]-ranks[2]) > 1e-9 {
+				t.Fatalf("equal weights: ranks %v", ranks)
+			}
+		case 9:
+			if ranks[1] <= 2*ranks[2] {
+				t.Fatalf("heavy edge: ranks %v", ranks)
+			}
+		}
+	}
+
+	if ranks := u.WeightedPageRank(graph.NewGraph(graph.DirectedWeighted, 0), 0.85, 10, 1e-9); len(ranks) != 0 {
+		t.Fatalf("empty graph: %v", ranks)
+	}
+}
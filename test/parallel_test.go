@@ -0,0 +1,100 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestParallelWorkerCount(t *testing.T) {
+	g := sparseGraph(80, 3)
+	expected := algorithm.NewUnit().BetweennessCentrality(g)
+
+	for _, workers := range []uint{1, 2, 8, 0} {
+		pu := algorithm.NewParallelUnit(workers)
+
+		if actual := pu.BetweennessCentrality(g); fmt.Sprint(actual) != fmt.Sprint(expected) {
+			t.Fatalf("betweenness with %d workers differs", workers)
+		}
+
+		pu.SetMaxWorkers(workers + 3)
+
+		local, global := pu.ClusteringCoefficient(g)
+		unitLocal, unitGlobal := algorithm.NewUnit().ClusteringCoefficient(g)
+
+		if fmt.Sprint(local) != fmt.Sprint(unitLocal) || global != unitGlobal {
+			t.Fatalf("clustering with %d workers differs", workers+3)
+		}
+
+		if fmt.Sprint(pu.InDegreeCentrality(g)) != fmt.Sprint(algorithm.NewUnit().InDegreeCentrality(g)) {
+			t.Fatalf("in-degree with %d workers differs", workers+3)
+		}
+	}
+}
+
+func benchmarkWorkers(b *testing.B, workers uint) {
+	g := sparseGraph(100, 3)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		pu := algorithm.NewParallelUnit(workers)
+		pu.BetweennessCentrality(g)
+	}
+}
+
+func BenchmarkParallelWorkers1(b *testing.B)  { benchmarkWorkers(b, 1) }
+func BenchmarkParallelWorkers4(b *testing.B)  { benchmarkWorkers(b, 4) }
+func BenchmarkParallelWorkers16(b *testing.B) { benchmarkWorkers(b, 16) }
+
+// sameBits reports whether two score maps hold bit-identical values.
+func sameBits(a, b map[graph.Identifier]float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for node, value := range a {
+		if other, ok := b[node]; !ok || math.Float64bits(value) != math.Float64bits(other) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestParallelDeterministic(t *testing.T) {
+	g := randomWeightedGraph(40, 9)
+	u := algorithm.NewUnit()
+
+	betweenness := u.BetweennessCentrality(g)
+	efficiency := u.LocalEfficiency(g)
+	eigenvector := u.EigenvectorCentrality(g, 100, 1e-9)
+	hubs, _ := u.HITS(g, 100, 1e-9)
+	diameter := u.Diameter(g)
+
+	for run := 0; run < 10; run++ {
+		pu := algorithm.NewParallelUnit(uint(run%4 + 1))
+
+		if !sameBits(pu.BetweennessCentrality(g), betweenness) {
+			t.Fatalf("run %d: betweenness differs from Unit", run)
+		}
+
+		if !sameBits(pu.LocalEfficiency(g), efficiency) {
+			t.Fatalf("run %d: local efficiency differs from Unit", run)
+		}
+
+		if !sameBits(pu.EigenvectorCentrality(g, 100, 1e-9), eigenvector) {
+			t.Fatalf("run %d: eigenvector centrality differs from Unit", run)
+		}
+
+		if parallelHubs, _ := pu.HITS(g, 100, 1e-9); !sameBits(parallelHubs, hubs) {
+			t.Fatalf("run %d: hub scores differ from Unit", run)
+		}
+
+		if fmt.Sprint(pu.Diameter(g).Nodes()) != fmt.Sprint(diameter.Nodes()) {
+			t.Fatalf("run %d: diameter path differs from Unit", run)
+		}
+	}
+}
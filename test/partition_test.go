@@ -0,0 +1,121 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// cutWeight counts the edges of an undirected unweighted graph between part and the other nodes.
+func cutWeight(g *graph.Graph, part []graph.Identifier) int {
+	inside := make(map[graph.Identifier]bool, len(part))
+	for _, id := range part {
+		inside[id] = true
+	}
+
+	cut := 0
+	for _, id := range part {
+		for _, to := range g.Neighbors(id) {
+			if !inside[to] {
+				cut++
+			}
+		}
+	}
+
+	return cut
+}
+
+func TestKernighanLin(t *testing.T) {
+	size := 5
+	g := barbellGraph(size)
+
+	// Start from the worst interleaved split of the two cliques.
+	a, b := []graph.Identifier{}, []graph.Identifier{}
+	for i := 0; i < size*2; i++ {
+		if i%2 == 0 {
+			a = append(a, graph.Identifier(i))
+		} else {
+			b = append(b, graph.Identifier(i))
+		}
+	}
+
+	before := cutWeight(g, a)
+	refinedA, refinedB := algorithm.KernighanLin(g, a, b, 10)
+
+	if len(refinedA) != len(a) || len(refinedB) != len(b) {
+		t.Fatalf("refinement changed the half sizes: %v | %v", refinedA, refinedB)
+	}
+
+	if after := cutWeight(g, refinedA); after > before || after != 1 {
+		t.Fatalf("cut before %d, after %d: %v | %v", before, after, refinedA, refinedB)
+	}
+
+	// Random graphs: the cut never increases, whatever the starting point.
+	for _, degree := range []int{3, 6} {
+		g := sparseGraph(40, degree)
+		a, b := algorithm.SpectralBisection(g)
+		before := cutWeight(g, a)
+
+		refinedA, _ := algorithm.KernighanLin(g, a, b, 5)
+		if after := cutWeight(g, refinedA); after > before {
+			t.Fatalf("degree %d: cut grew from %d to %d", degree, before, after)
+		}
+	}
+}
+
+func TestCutSizeConductance(t *testing.T) {
+	// Two triangles 0-1-2 and 3-4-5 joined by the bridge 2-3 of weight 2.
+	g := graph.NewGraph(graph.UndirectedWeighted, 6)
+	for i := 0; i < 6; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for _, offset := range []graph.Identifier{0, 3} {
+		g.AddWeightEdge(offset, offset+1, 1)
+		g.AddWeightEdge(offset+1, offset+2, 1)
+		g.AddWeightEdge(offset+2, offset, 1)
+	}
+
+	g.AddWeightEdge(2, 3, 2)
+
+	left, right := []graph.Identifier{0, 1, 2}, []graph.Identifier{3, 4, 5}
+
+	if cut := algorithm.CutSize(g, left, right); cut != 2 {
+		t.Fatalf("cut between the triangles: %d, want 2", cut)
+	}
+
+	if cut := algorithm.CutSize(g, []graph.Identifier{0, 1}, right); cut != 0 {
+		t.Fatalf("cut between non-adjacent sets: %d, want 0", cut)
+	}
+
+	// Both halves have volume 2 + 2 + 4 = 8.
+	if c := algorithm.Conductance(g, left); math.Abs(c-2.0/8) > 1e-12 {
+		t.Fatalf("conductance of a triangle: %f, want 0.25", c)
+	}
+
+	// A single node of degree 2 has all its edges leaving it.
+	if c := algorithm.Conductance(g, []graph.Identifier{0}); math.Abs(c-1) > 1e-12 {
+		t.Fatalf("conductance of a single node: %f, want 1", c)
+	}
+
+	if c := algorithm.Conductance(g, nil); c != 0 {
+		t.Fatalf("conductance of the empty set: %f, want 0", c)
+	}
+
+	// Directed edges count in both directions.
+	d := graph.NewGraph(graph.DirectedUnweighted, 3)
+	for i := 0; i < 3; i++ {
+		d.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	d.AddEdge(0, 1)
+	d.AddEdge(2, 0)
+	d.AddEdge(1, 2)
+
+	if cut := algorithm.CutSize(d, []graph.Identifier{0}, []graph.Identifier{1, 2}); cut != 2 {
+		t.Fatalf("directed cut: %d, want 2", cut)
+	}
+}
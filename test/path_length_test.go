@@ -47,3 +47,53 @@ func TestAverageShortestPathLength(t *testing.T) {
 		t.Logf("%f: %d", i, u.PercentileShortestPathLength(g, i))
 	}
 }
+
+func TestPrecompute(t *testing.T) {
+	for _, u := range []interface {
+		Precompute(*graph.Graph)
+		IsComputed() bool
+		ClosenessCentrality(*graph.Graph) map[graph.Identifier]float64
+		AverageShortestPathLength(*graph.Graph) float64
+		Diameter(*graph.Graph) graph.Path
+	}{algorithm.NewUnit(), algorithm.NewParallelUnit(4)} {
+		g := sparseGraph(50, 3)
+
+		if u.IsComputed() {
+			t.Fatal("a new unit must not hold paths")
+		}
+
+		u.Precompute(g)
+
+		if !u.IsComputed() {
+			t.Fatal("Precompute did not fill the cache")
+		}
+
+		closeness := u.ClosenessCentrality(g)
+		aspl := u.AverageShortestPathLength(g)
+		diameter := u.Diameter(g)
+
+		// Shortcut the graph behind the unit's back: a recompute would see the new edges, the cache does not.
+		for i := 1; i < g.NodeCount(); i++ {
+			g.AddEdge(0, graph.Identifier(i))
+		}
+		g.Update()
+
+		if !sameBits(u.ClosenessCentrality(g), closeness) || u.AverageShortestPathLength(g) != aspl ||
+			u.Diameter(g).Distance() != diameter.Distance() {
+			t.Fatal("metric calls after Precompute recomputed the paths")
+		}
+
+		// Precompute itself keeps a valid cache, and recomputes once the graph is marked as modified.
+		u.Precompute(g)
+		if u.Diameter(g).Distance() != diameter.Distance() {
+			t.Fatal("Precompute recomputed a valid cache")
+		}
+
+		g.SetNodeWeight(0, 2)
+		u.Precompute(g)
+
+		if u.Diameter(g).Distance() > 2 {
+			t.Fatalf("Precompute on a modified graph kept stale paths: diameter %d", u.Diameter(g).Distance())
+		}
+	}
+}
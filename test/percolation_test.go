@@ -0,0 +1,50 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestNodePercolationStar(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedUnweighted, 10)
+	for i := 0; i < 10; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < 10; i++ {
+		g.AddEdge(0, graph.Identifier(i))
+	}
+
+	// Without an order the hub is removed first and only isolated leaves remain.
+	sizes := algorithm.NodePercolation(g, nil)
+	if len(sizes) != 10 || sizes[0] != 1 || sizes[9] != 0 {
+		t.Fatalf("targeted attack on a star: %v", sizes)
+	}
+
+	// Removing leaves first only shrinks the star by one node at a time.
+	sizes = algorithm.NodePercolation(g, []graph.Identifier{9, 8, 8, 42, 0})
+	want := []int{9, 8, 1}
+
+	if fmt.Sprint(sizes) != fmt.Sprint(want) {
+		t.Fatalf("leaf attack on a star: %v, want %v", sizes, want)
+	}
+}
+
+func TestNodePercolationPath(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedUnweighted, 7)
+	for i := 0; i < 7; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 1; i < 7; i++ {
+		g.AddEdge(graph.Identifier(i-1), graph.Identifier(i))
+	}
+
+	sizes := algorithm.NodePercolation(g, []graph.Identifier{3, 1})
+	if fmt.Sprint(sizes) != fmt.Sprint([]int{3, 3}) {
+		t.Fatalf("path attack: %v", sizes)
+	}
+}
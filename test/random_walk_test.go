@@ -0,0 +1,82 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestBiasedRandomWalk(t *testing.T) {
+	// A 21x21 grid, walked from its center.
+	side := 21
+	g := graph.NewGraph(graph.UndirectedUnweighted, side*side)
+
+	for i := 0; i < side*side; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for row := 0; row < side; row++ {
+		for col := 0; col < side; col++ {
+			id := graph.Identifier(row*side + col)
+
+			if col+1 < side {
+				g.AddEdge(id, id+1)
+			}
+			if row+1 < side {
+				g.AddEdge(id, id+graph.Identifier(side))
+			}
+		}
+	}
+
+	center := graph.Identifier(side * side / 2)
+
+	distinct := func(q float64) int {
+		count := 0
+
+		for seed := int64(0); seed < 20; seed++ {
+			walk := algorithm.BiasedRandomWalk(g, center, 40, 1, q, seed)
+			if len(walk) != 40 || walk[0] != center {
+				t.Fatalf("walk of length %d from %d", len(walk), walk[0])
+			}
+
+			visited := map[graph.Identifier]bool{}
+			for i, id := range walk {
+				visited[id] = true
+
+				if i > 0 {
+					adjacent := false
+					for _, next := range g.Neighbors(walk[i-1]) {
+						adjacent = adjacent || next == id
+					}
+
+					if !adjacent {
+						t.Fatalf("step %d -> %d is not an edge", walk[i-1], id)
+					}
+				}
+			}
+
+			count += len(visited)
+		}
+
+		return count
+	}
+
+	outward, local := distinct(0.1), distinct(10)
+	if outward <= local {
+		t.Fatalf("low q visited %d distinct nodes, high q %d", outward, local)
+	}
+
+	// The same seed reproduces the same walk.
+	a := algorithm.BiasedRandomWalk(g, center, 30, 0.5, 2, 7)
+	b := algorithm.BiasedRandomWalk(g, center, 30, 0.5, 2, 7)
+
+	if fmt.Sprint(a) != fmt.Sprint(b) {
+		t.Fatal("walks with the same seed differ")
+	}
+
+	if len(algorithm.BiasedRandomWalk(g, graph.Identifier(10000), 10, 1, 1, 1)) != 0 {
+		t.Fatal("walk from a missing node must be empty")
+	}
+}
@@ -0,0 +1,44 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestTopK(t *testing.T) {
+	scores := map[graph.Identifier]float64{
+		0: 0.5,
+		1: 0.9,
+		2: 0.5,
+		3: 0.1,
+		4: 0.9,
+		5: 0.5,
+	}
+
+	expected := []algorithm.NodeScore{
+		{Node: 1, Score: 0.9},
+		{Node: 4, Score: 0.9},
+		{Node: 0, Score: 0.5},
+		{Node: 2, Score: 0.5},
+	}
+
+	for run := 0; run < 10; run++ {
+		top := algorithm.TopK(scores, 4)
+
+		if len(top) != len(expected) {
+			t.Fatalf("expected %d nodes, got %v", len(expected), top)
+		}
+
+		for i := range expected {
+			if top[i] != expected[i] {
+				t.Fatalf("position %d: expected %v, got %v", i, expected[i], top[i])
+			}
+		}
+	}
+
+	if len(algorithm.TopK(scores, 100)) != len(scores) {
+		t.Fatal("k larger than the map must return every node")
+	}
+}
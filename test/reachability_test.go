@@ -0,0 +1,40 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestTransitiveClosure(t *testing.T) {
+	cap := 5
+	g := graph.NewGraph(graph.DirectedUnweighted, cap)
+
+	for i := 0; i < cap; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// A directed chain 0 -> 1 -> 2 -> 3 -> 4.
+	for i := 1; i < cap; i++ {
+		g.AddEdge(graph.Identifier(i-1), graph.Identifier(i))
+	}
+
+	closure := algorithm.TransitiveClosure(g)
+	matrix := closure.ToMatrix()
+	t.Logf("\n%s", matrix.String())
+
+	// The closure of a chain is the full upper triangle.
+	for i := 0; i < cap; i++ {
+		for j := 0; j < cap; j++ {
+			if (i < j) != (matrix[i][j] != graph.INF) {
+				t.Fatalf("[%d][%d] = %d", i, j, matrix[i][j])
+			}
+		}
+	}
+
+	if closure.EdgeCount() != cap*(cap-1)/2 {
+		t.Fatalf("expected %d edges, got %d", cap*(cap-1)/2, closure.EdgeCount())
+	}
+}
@@ -0,0 +1,43 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestReciprocity(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedUnweighted, 4)
+
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// Two reciprocated edges (0 <-> 1) and two one-way edges.
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 0)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+
+	if r := algorithm.Reciprocity(g); r != 0.5 {
+		t.Fatalf("expected 0.5, got %f", r)
+	}
+
+	u := graph.NewGraph(graph.UndirectedUnweighted, 3)
+	for i := 0; i < 3; i++ {
+		u.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	if r := algorithm.Reciprocity(u); r != 0 {
+		t.Fatalf("a graph without edges must score 0, got %f", r)
+	}
+
+	u.AddEdge(0, 1)
+	u.AddEdge(1, 2)
+
+	if r := algorithm.Reciprocity(u); r != 1 {
+		t.Fatalf("an undirected graph must score 1, got %f", r)
+	}
+}
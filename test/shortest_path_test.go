@@ -0,0 +1,256 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestHopDistances(t *testing.T) {
+	g := graph.NewGraph(graph.DirectedWeighted, 5)
+
+	for i := 0; i < 5; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// The direct edge 0 -> 3 is heavy; the three-hop route 0 -> 1 -> 2 -> 3 is light.
+	g.AddWeightEdge(0, 3, 10)
+	g.AddWeightEdge(0, 1, 1)
+	g.AddWeightEdge(1, 2, 1)
+	g.AddWeightEdge(2, 3, 1)
+
+	u := algorithm.NewUnit()
+	hops := u.HopDistances(g, 0)
+	t.Logf("hops: %v\n", hops)
+
+	if hops[3] != 1 || hops[2] != 2 || hops[0] != 0 {
+		t.Fatalf("unexpected hop distances: %v", hops)
+	}
+
+	if hops[4] != -1 {
+		t.Fatalf("unreachable node must map to -1, got %d", hops[4])
+	}
+
+	weighted := algorithm.ShortestPath(g, 0, 3)
+	if weighted.Distance() != 3 || len(weighted.Nodes())-1 == hops[3] {
+		t.Fatalf("weighted path %v must differ from the hop route", weighted.Nodes())
+	}
+}
+
+func TestCompactPaths(t *testing.T) {
+	for _, g := range []*graph.Graph{randomWeightedGraph(40, 13), sparseGraph(60, 3)} {
+		explicit := algorithm.NewUnit()
+		compact := algorithm.NewUnit()
+		compact.SetCompactPaths(true)
+
+		parallel := algorithm.NewParallelUnit(4)
+		parallel.SetCompactPaths(true)
+
+		if fmt.Sprint(compact.BetweennessCentrality(g)) != fmt.Sprint(explicit.BetweennessCentrality(g)) ||
+			fmt.Sprint(parallel.BetweennessCentrality(g)) != fmt.Sprint(explicit.BetweennessCentrality(g)) {
+			t.Fatal("compact betweenness differs from explicit paths")
+		}
+
+		if fmt.Sprint(compact.EdgeBetweenness(g)) != fmt.Sprint(explicit.EdgeBetweenness(g)) {
+			t.Fatal("compact edge betweenness differs from explicit paths")
+		}
+
+		if compact.AverageShortestPathLength(g) != explicit.AverageShortestPathLength(g) ||
+			compact.PercentileShortestPathLength(g, 0.9) != explicit.PercentileShortestPathLength(g, 0.9) {
+			t.Fatal("compact path lengths differ from explicit paths")
+		}
+
+		if fmt.Sprint(compact.Diameter(g)) != fmt.Sprint(explicit.Diameter(g)) {
+			t.Fatalf("compact diameter %v differs from %v", compact.Diameter(g), explicit.Diameter(g))
+		}
+
+		if compact.GlobalEfficiency(g) != explicit.GlobalEfficiency(g) ||
+			fmt.Sprint(parallel.LocalEfficiency(g)) != fmt.Sprint(explicit.LocalEfficiency(g)) {
+			t.Fatal("compact efficiency differs from explicit paths")
+		}
+	}
+}
+
+func TestAllShortestPaths(t *testing.T) {
+	// A weighted diamond 0 -> {1, 2} -> 3 with two routes of length 3, plus a longer detour through 4.
+	g := graph.NewGraph(graph.DirectedWeighted, 5)
+
+	for i := 0; i < 5; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddWeightEdge(0, 1, 1)
+	g.AddWeightEdge(0, 2, 2)
+	g.AddWeightEdge(1, 3, 2)
+	g.AddWeightEdge(2, 3, 1)
+	g.AddWeightEdge(0, 4, 1)
+	g.AddWeightEdge(4, 3, 5)
+
+	u := algorithm.NewUnit()
+	paths := u.AllShortestPaths(g, 0, 3)
+
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 shortest paths, got %d", len(paths))
+	}
+
+	if fmt.Sprint(paths[0].Nodes()) != "[0 1 3]" || fmt.Sprint(paths[1].Nodes()) != "[0 2 3]" {
+		t.Fatalf("unexpected paths: %v, %v", paths[0].Nodes(), paths[1].Nodes())
+	}
+
+	for _, path := range paths {
+		if path.Distance() != 3 {
+			t.Fatalf("path %v has distance %d, want 3", path.Nodes(), path.Distance())
+		}
+	}
+
+	if len(u.AllShortestPaths(g, 3, 0)) != 0 {
+		t.Fatal("an unreachable target must have no paths")
+	}
+
+	// A 4-cycle is an unweighted diamond between opposite corners.
+	cycle := graph.NewGraph(graph.UndirectedUnweighted, 4)
+	for i := 0; i < 4; i++ {
+		cycle.AddNode(fmt.Sprintf("%4d", i))
+	}
+	for i := 0; i < 4; i++ {
+		cycle.AddEdge(graph.Identifier(i), graph.Identifier((i+1)%4))
+	}
+
+	if paths := u.AllShortestPaths(cycle, 0, 2); len(paths) != 2 {
+		t.Fatalf("expected 2 shortest paths around the cycle, got %d", len(paths))
+	}
+}
+
+func TestMultiSourceBFS(t *testing.T) {
+	g := graph.NewGraph(graph.UndirectedUnweighted, 8)
+
+	for i := 0; i < 8; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	// A path 0-1-...-6 and an isolated node 7.
+	for i := 1; i < 7; i++ {
+		g.AddEdge(graph.Identifier(i-1), graph.Identifier(i))
+	}
+
+	distance, nearest := algorithm.MultiSourceBFS(g, []graph.Identifier{6, 0, 6, 100})
+
+	wantDistance := []int{0, 1, 2, 3, 2, 1, 0, -1}
+	wantNearest := []graph.Identifier{0, 0, 0, 0, 6, 6, 6}
+
+	for i, want := range wantDistance {
+		if distance[graph.Identifier(i)] != want {
+			t.Fatalf("node %d: distance %d, want %d", i, distance[graph.Identifier(i)], want)
+		}
+	}
+
+	for i, want := range wantNearest {
+		if nearest[graph.Identifier(i)] != want {
+			t.Fatalf("node %d: nearest source %d, want %d", i, nearest[graph.Identifier(i)], want)
+		}
+	}
+
+	if _, ok := nearest[7]; ok {
+		t.Fatal("an unreachable node must have no nearest source")
+	}
+}
+
+func TestShortestPathFunc(t *testing.T) {
+	// A grid-like graph with two routes from 0 to 3: through 1 (length 2) or through 2 and 4 (length 3).
+	g := graph.NewGraph(graph.UndirectedWeighted, 5)
+
+	for i := 0; i < 5; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddWeightEdge(0, 1, 1)
+	g.AddWeightEdge(1, 3, 1)
+	g.AddWeightEdge(0, 2, 1)
+	g.AddWeightEdge(2, 4, 1)
+	g.AddWeightEdge(4, 3, 1)
+
+	u := algorithm.NewUnit()
+	identity := func(from, to graph.Identifier, base int64) int64 { return base }
+
+	path, ok := u.ShortestPathFunc(g, 0, 3, identity)
+	if !ok || fmt.Sprint(path.Nodes()) != "[0 1 3]" || path.Distance() != 2 {
+		t.Fatalf("identity cost: %v (%d)", path.Nodes(), path.Distance())
+	}
+
+	// Entering node 1 costs an extra 5, so the longer route becomes optimal.
+	penalty := func(from, to graph.Identifier, base int64) int64 {
+		if to == 1 {
+			return base + 5
+		}
+
+		return base
+	}
+
+	path, ok = u.ShortestPathFunc(g, 0, 3, penalty)
+	if !ok || fmt.Sprint(path.Nodes()) != "[0 2 4 3]" || path.Distance() != 3 {
+		t.Fatalf("penalized cost: %v (%d)", path.Nodes(), path.Distance())
+	}
+
+	negative := func(from, to graph.Identifier, base int64) int64 { return -base }
+	if _, ok := u.ShortestPathFunc(g, 0, 3, negative); ok {
+		t.Fatal("negative costs must be rejected")
+	}
+
+	g.AddNode(fmt.Sprintf("%4d", 5))
+	if path, ok := u.ShortestPathFunc(g, 0, 5, identity); ok || path.Distance() != graph.INF {
+		t.Fatal("an unreachable node must not have a path")
+	}
+}
+
+func TestWidestPath(t *testing.T) {
+	// The direct route 0-1-3 is short but narrow; the detour 0-2-4-3 is longer but wide.
+	g := graph.NewGraph(graph.UndirectedWeighted, 5)
+
+	for i := 0; i < 5; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddWeightEdge(0, 1, 2)
+	g.AddWeightEdge(1, 3, 2)
+	g.AddWeightEdge(0, 2, 10)
+	g.AddWeightEdge(2, 4, 8)
+	g.AddWeightEdge(4, 3, 9)
+
+	u := algorithm.NewUnit()
+
+	if shortest := algorithm.ShortestPath(g, 0, 3); fmt.Sprint(shortest.Nodes()) != "[0 1 3]" {
+		t.Fatalf("shortest path: %v", shortest.Nodes())
+	}
+
+	path, bottleneck, ok := u.WidestPath(g, 0, 3)
+	if !ok || fmt.Sprint(path.Nodes()) != "[0 2 4 3]" || bottleneck != 8 || path.Distance() != 27 {
+		t.Fatalf("widest path: %v (bottleneck %d, length %d)", path.Nodes(), bottleneck, path.Distance())
+	}
+
+	if _, bottleneck, ok := u.WidestPath(g, 2, 2); !ok || bottleneck != algorithm.INF64 {
+		t.Fatalf("trivial path: bottleneck %d", bottleneck)
+	}
+
+	g.AddNode(fmt.Sprintf("%4d", 5))
+	if path, _, ok := u.WidestPath(g, 0, 5); ok || path.Distance() != graph.INF {
+		t.Fatal("an unreachable node must not have a widest path")
+	}
+}
+
+func benchmarkPathStorage(b *testing.B, cap int, compact bool) {
+	g := sparseGraph(cap, 3)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		u := algorithm.NewParallelUnit(0)
+		u.SetCompactPaths(compact)
+		u.AverageShortestPathLength(g)
+	}
+}
+
+func BenchmarkExplicitPaths1k(b *testing.B) { benchmarkPathStorage(b, 1000, false) }
+func BenchmarkCompactPaths1k(b *testing.B)  { benchmarkPathStorage(b, 1000, true) }
+func BenchmarkCompactPaths5k(b *testing.B)  { benchmarkPathStorage(b, 5000, true) }
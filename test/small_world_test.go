@@ -0,0 +1,56 @@
+package test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+// wattsStrogatz builds a ring lattice of n nodes, each joined to its k nearest neighbors,
+// and rewires the far end of every edge with probability p.
+func wattsStrogatz(n, k int, p float64, seed int64) *graph.Graph {
+	g := graph.NewGraph(graph.UndirectedUnweighted, n)
+	r := rand.New(rand.NewSource(seed))
+
+	for i := 0; i < n; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 1; j <= k/2; j++ {
+			to := graph.Identifier((i + j) % n)
+
+			if r.Float64() < p {
+				// Retry until the new end is neither the node itself nor an existing neighbor.
+				for g.AddEdge(graph.Identifier(i), graph.Identifier(r.Intn(n))) != nil {
+				}
+
+				continue
+			}
+
+			g.AddEdge(graph.Identifier(i), to)
+		}
+	}
+
+	return g
+}
+
+func TestSmallWorldSigma(t *testing.T) {
+	g := wattsStrogatz(200, 6, 0.1, 1)
+
+	sigma := algorithm.SmallWorldSigma(g, 7)
+	t.Logf("small-world sigma: %f", sigma)
+
+	if sigma < 3 {
+		t.Fatalf("a Watts-Strogatz graph should be a small world, sigma %f", sigma)
+	}
+
+	// A random graph of the same density is its own reference.
+	random, _, _ := algorithm.GenerateSBM([]int{200}, [][]float64{{float64(g.EdgeCount()) / (200 * 199 / 2)}}, 3)
+	if sigma := algorithm.SmallWorldSigma(random, 7); sigma < 0.5 || sigma > 2 {
+		t.Fatalf("a random graph should have sigma near 1, got %f", sigma)
+	}
+}
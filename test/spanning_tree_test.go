@@ -0,0 +1,52 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestIsMSTUnique(t *testing.T) {
+	// A square 0-1-2-3 with the diagonal 0-2.
+	build := func(weights [5]graph.Distance) *graph.Graph {
+		g := graph.NewGraph(graph.UndirectedWeighted, 4)
+		for i := 0; i < 4; i++ {
+			g.AddNode(fmt.Sprintf("%4d", i))
+		}
+
+		for i, pair := range [][2]graph.Identifier{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {0, 2}} {
+			g.AddWeightEdge(pair[0], pair[1], weights[i])
+		}
+
+		return g
+	}
+
+	if !algorithm.IsMSTUnique(build([5]graph.Distance{1, 2, 3, 4, 5})) {
+		t.Fatal("distinct weights give a unique tree")
+	}
+
+	// 2-3 and 3-0 tie as the heaviest edges on the cycle 0-2-3 closed by either of them.
+	if algorithm.IsMSTUnique(build([5]graph.Distance{1, 2, 4, 4, 3})) {
+		t.Fatal("the tie between 2-3 and 3-0 allows two trees")
+	}
+
+	// Ties that never compete on a cycle do not matter: both weight-1 edges are in every tree.
+	if !algorithm.IsMSTUnique(build([5]graph.Distance{1, 1, 2, 5, 6})) {
+		t.Fatal("ties between tree edges keep the tree unique")
+	}
+
+	// Every spanning tree of an unweighted cycle has the same weight.
+	cycle := graph.NewGraph(graph.UndirectedUnweighted, 4)
+	for i := 0; i < 4; i++ {
+		cycle.AddNode(fmt.Sprintf("%4d", i))
+	}
+	for i := 0; i < 4; i++ {
+		cycle.AddEdge(graph.Identifier(i), graph.Identifier((i+1)%4))
+	}
+
+	if algorithm.IsMSTUnique(cycle) {
+		t.Fatal("an unweighted cycle has several spanning trees")
+	}
+}
@@ -0,0 +1,91 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestAlgebraicConnectivity(t *testing.T) {
+	size := 5
+
+	complete := graph.NewGraph(graph.UndirectedUnweighted, size*2)
+	for i := 0; i < size*2; i++ {
+		complete.AddNode(fmt.Sprintf("%4d", i))
+	}
+	for i := 0; i < size*2; i++ {
+		for j := i + 1; j < size*2; j++ {
+			complete.AddEdge(graph.Identifier(i), graph.Identifier(j))
+		}
+	}
+
+	// The complete graph K_n has algebraic connectivity n.
+	dense := algorithm.AlgebraicConnectivity(complete, 200, 1e-12)
+	if math.Abs(dense-float64(size*2)) > 1e-9 {
+		t.Fatalf("complete graph: expected %d, got %f", size*2, dense)
+	}
+
+	// Two cliques joined by a single bridge are nearly split.
+	barbell := barbellGraph(size)
+	split := algorithm.AlgebraicConnectivity(barbell, 200, 1e-12)
+
+	if split <= 0 || split >= dense {
+		t.Fatalf("barbell graph: %f, complete graph: %f", split, dense)
+	}
+
+	// The Fiedler vector separates the two cliques by sign.
+	vector := algorithm.FiedlerVector(barbell, 200, 1e-12)
+	for i := 0; i < size*2; i++ {
+		if (i < size) != (vector[graph.Identifier(i)] < 0) {
+			t.Fatalf("fiedler vector does not split the cliques: %v", vector)
+		}
+	}
+
+	// The path P_n has algebraic connectivity 2(1 - cos(pi/n)).
+	path := graph.NewGraph(graph.UndirectedUnweighted, 6)
+	for i := 0; i < 6; i++ {
+		path.AddNode(fmt.Sprintf("%4d", i))
+	}
+	for i := 1; i < 6; i++ {
+		path.AddEdge(graph.Identifier(i-1), graph.Identifier(i))
+	}
+
+	expected := 2 * (1 - math.Cos(math.Pi/6))
+	if actual := algorithm.AlgebraicConnectivity(path, 500, 1e-12); math.Abs(actual-expected) > 1e-9 {
+		t.Fatalf("path graph: expected %f, got %f", expected, actual)
+	}
+
+	// Removing the bridge disconnects the barbell.
+	barbell.RemoveEdge(graph.Identifier(size-1), graph.Identifier(size))
+
+	if value := algorithm.AlgebraicConnectivity(barbell, 200, 1e-12); value != 0 {
+		t.Fatalf("disconnected graph: expected 0, got %f", value)
+	}
+
+	vector = algorithm.FiedlerVector(barbell, 200, 1e-12)
+	if vector[0] >= 0 || vector[graph.Identifier(size)] <= 0 {
+		t.Fatalf("disconnected fiedler vector: %v", vector)
+	}
+}
+
+func TestSpectralBisection(t *testing.T) {
+	size := 6
+	a, b := algorithm.SpectralBisection(barbellGraph(size))
+
+	if len(a) != size || len(b) != size {
+		t.Fatalf("unbalanced bisection: %v | %v", a, b)
+	}
+
+	for i := 0; i < size; i++ {
+		if a[i] != graph.Identifier(i) || b[i] != graph.Identifier(size+i) {
+			t.Fatalf("bisection does not separate the cliques: %v | %v", a, b)
+		}
+	}
+
+	if a, b := algorithm.SpectralBisection(graph.NewGraph(graph.UndirectedUnweighted, 0)); len(a)+len(b) != 0 {
+		t.Fatalf("empty graph: %v | %v", a, b)
+	}
+}
@@ -0,0 +1,87 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestUnitSaveLoad(t *testing.T) {
+	g := randomWeightedGraph(40, 3)
+
+	for _, compact := range []bool{false, true} {
+		u := algorithm.NewUnit()
+		u.SetCompactPaths(compact)
+		u.Precompute(g)
+
+		var buffer bytes.Buffer
+		if e := u.Save(&buffer); e != nil {
+			t.Fatal(e)
+		}
+
+		// A later run rebuilds the graph from its own serialized form.
+		data, _ := json.Marshal(g)
+		restored := &graph.Graph{}
+		if e := json.Unmarshal(data, restored); e != nil {
+			t.Fatal(e)
+		}
+
+		loaded, e := algorithm.LoadUnit(bytes.NewReader(buffer.Bytes()))
+		if e != nil {
+			t.Fatal(e)
+		}
+
+		if loaded.IsComputed() {
+			t.Fatal("a loaded unit is computed before it is attached")
+		}
+
+		if e := loaded.Attach(restored); e != nil {
+			t.Fatal(e)
+		}
+
+		if !loaded.IsComputed() {
+			t.Fatal("an attached unit is not computed")
+		}
+
+		fresh := algorithm.NewUnit()
+
+		if !sameBits(loaded.BetweennessCentrality(restored), fresh.BetweennessCentrality(g)) {
+			t.Fatalf("compact %v: loaded betweenness differs", compact)
+		}
+
+		if !sameBits(loaded.ClosenessCentrality(restored), fresh.ClosenessCentrality(g)) {
+			t.Fatalf("compact %v: loaded closeness differs", compact)
+		}
+
+		if fmt.Sprint(loaded.EdgeBetweenness(restored)) != fmt.Sprint(fresh.EdgeBetweenness(g)) {
+			t.Fatalf("compact %v: loaded edge betweenness differs", compact)
+		}
+
+		// A graph with another structure is refused, and the first metric call recomputes the paths for it.
+		changed := randomWeightedGraph(40, 4)
+
+		loaded, _ = algorithm.LoadUnit(bytes.NewReader(buffer.Bytes()))
+		if e := loaded.Attach(changed); e == nil {
+			t.Fatalf("compact %v: attached to a different graph", compact)
+		}
+
+		if !sameBits(loaded.ClosenessCentrality(changed), algorithm.NewUnit().ClosenessCentrality(changed)) {
+			t.Fatalf("compact %v: closeness of a different graph was read from the loaded paths", compact)
+		}
+	}
+
+	if e := algorithm.NewUnit().Save(&bytes.Buffer{}); e == nil {
+		t.Fatal("saved a unit without computed paths")
+	}
+
+	for _, invalid := range []string{"", "{", `{"compact": true, "ids": [0, 1], "dist": [[0, 1]], "prev": [[-1, 0]]}`} {
+		if _, e := algorithm.LoadUnit(strings.NewReader(invalid)); e == nil {
+			t.Fatalf("loaded %q", invalid)
+		}
+	}
+}
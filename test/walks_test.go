@@ -0,0 +1,120 @@
+package test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/elecbug/go-graphtric/algorithm"
+	"github.com/elecbug/go-graphtric/graph"
+)
+
+func TestPathCountMatrix(t *testing.T) {
+	g := sparseGraph(30, 4)
+	squared := algorithm.PathCountMatrix(g, 2)
+
+	// On an undirected graph every closed walk of length 2 goes to a neighbor and back.
+	for _, id := range g.NodeIDs() {
+		if squared[id][id] != int64(len(g.Neighbors(id))) {
+			t.Fatalf("A^2[%d][%d] = %d, degree %d", id, id, squared[id][id], len(g.Neighbors(id)))
+		}
+	}
+
+	// A directed 3-cycle returns to its start exactly every third step.
+	cycle := graph.NewGraph(graph.DirectedUnweighted, 3)
+	for i := 0; i < 3; i++ {
+		cycle.AddNode(fmt.Sprintf("%4d", i))
+	}
+	for i := 0; i < 3; i++ {
+		cycle.AddEdge(graph.Identifier(i), graph.Identifier((i+1)%3))
+	}
+
+	for length, expected := range map[int]int64{0: 1, 1: 0, 3: 1, 5: 0, 9: 1} {
+		if actual := algorithm.PathCountMatrix(cycle, length)[0][0]; actual != expected {
+			t.Fatalf("closed walks of length %d: expected %d, got %d", length, expected, actual)
+		}
+	}
+
+	if algorithm.PathCountMatrix(cycle, -1) != nil {
+		t.Fatal("a negative length must yield nil")
+	}
+
+	// Walk counts on a complete graph grow as (n-1)^k and saturate instead of overflowing.
+	complete := graph.NewGraph(graph.UndirectedUnweighted, 10)
+	for i := 0; i < 10; i++ {
+		complete.AddNode(fmt.Sprintf("%4d", i))
+	}
+	for i := 0; i < 10; i++ {
+		for j := i + 1; j < 10; j++ {
+			complete.AddEdge(graph.Identifier(i), graph.Identifier(j))
+		}
+	}
+
+	if value := algorithm.PathCountMatrix(complete, 40)[0][1]; value != algorithm.INF64 {
+		t.Fatalf("expected saturation, got %d", value)
+	}
+}
+
+func TestCommunicabilityCentrality(t *testing.T) {
+	g := sparseGraph(30, 3)
+
+	// Thirty terms already agree with sixty to within the truncation bound.
+	truncated := algorithm.CommunicabilityCentrality(g, 30)
+	reference := algorithm.CommunicabilityCentrality(g, 60)
+
+	for _, id := range g.NodeIDs() {
+		if math.Abs(truncated[id]-reference[id]) > 1e-9*reference[id] {
+			t.Fatalf("node %d: %f with 30 terms, %f with 60", id, truncated[id], reference[id])
+		}
+	}
+
+	// A single edge has exp(A) = [[cosh 1, sinh 1], [sinh 1, cosh 1]], so each row sums to e.
+	edge := graph.NewGraph(graph.UndirectedUnweighted, 2)
+	edge.AddNode(fmt.Sprintf("%4d", 0))
+	edge.AddNode(fmt.Sprintf("%4d", 1))
+	edge.AddEdge(0, 1)
+
+	for id, score := range algorithm.CommunicabilityCentrality(edge, 30) {
+		if math.Abs(score-math.E) > 1e-12 {
+			t.Fatalf("node %d: communicability %f, want e", id, score)
+		}
+	}
+
+	// With a single term only the walk of length 0 is counted.
+	for id, score := range algorithm.CommunicabilityCentrality(g, 1) {
+		if score != 1 {
+			t.Fatalf("node %d: one-term communicability %f, want 1", id, score)
+		}
+	}
+}
+
+func TestSubgraphCentrality(t *testing.T) {
+	// A triangle 0-1-2 with a pendant node 3 attached to 2.
+	g := graph.NewGraph(graph.UndirectedUnweighted, 4)
+	for i := 0; i < 4; i++ {
+		g.AddNode(fmt.Sprintf("%4d", i))
+	}
+
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+
+	scores := algorithm.SubgraphCentrality(g, 30)
+
+	if scores[0] <= scores[3] || scores[2] <= scores[0] {
+		t.Fatalf("subgraph centrality: %v", scores)
+	}
+
+	// The diagonal of exp(A) for a single edge is cosh 1.
+	edge := graph.NewGraph(graph.UndirectedUnweighted, 2)
+	edge.AddNode(fmt.Sprintf("%4d", 0))
+	edge.AddNode(fmt.Sprintf("%4d", 1))
+	edge.AddEdge(0, 1)
+
+	for id, score := range algorithm.SubgraphCentrality(edge, 30) {
+		if math.Abs(score-math.Cosh(1)) > 1e-12 {
+			t.Fatalf("node %d: subgraph centrality %f, want cosh 1", id, score)
+		}
+	}
+}